@@ -0,0 +1,43 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/4mewes/pokedex/internal/pokeapi"
+)
+
+func commandAbility(conf *config, args ...string) error {
+	if len(args) == 0 {
+		fmt.Println("please provide an ability name")
+		return nil
+	}
+	abilityName, ok := resolveName(conf, "ability", args[0])
+	if !ok {
+		return nil
+	}
+	url := "https://pokeapi.co/api/v2/ability/" + abilityName + "/"
+
+	abilityInfoRes, err := pokeapi.GetAbilityInfo(url, conf.cache)
+	if err != nil {
+		fmt.Println("error in GetAbilityInfo: %w", err)
+		return fmt.Errorf("error in GetAbilityInfo: %w", err)
+	}
+
+	fmt.Printf("Ability: %s\n", localizedName(conf, abilityInfoRes.Names, abilityInfoRes.Name))
+	fmt.Printf("Introduced in: %s\n", abilityInfoRes.Generation.Name)
+	if effect := localizedEffect(conf, abilityInfoRes.EffectEntries); effect != "" {
+		fmt.Printf("Effect: %s\n", effect)
+	}
+	if flavorText := flavorTextForGame(conf, abilityInfoRes.FlavorTextEntries); flavorText != "" {
+		fmt.Printf("Description: %s\n", flavorText)
+	}
+	fmt.Println("Pokemon with this ability:")
+	for _, abilityPokemon := range abilityInfoRes.Pokemon {
+		if abilityPokemon.IsHidden {
+			fmt.Printf("  - %s (hidden)\n", abilityPokemon.Pokemon.Name)
+		} else {
+			fmt.Printf("  - %s\n", abilityPokemon.Pokemon.Name)
+		}
+	}
+	return nil
+}
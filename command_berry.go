@@ -0,0 +1,154 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/4mewes/pokedex/internal/pokeapi"
+)
+
+type berryRow struct {
+	berry   pokeapi.BerryInfo
+	potency int
+}
+
+// berryDisplayName localizes a berry through its item, since berries have
+// no names of their own.
+func berryDisplayName(conf *config, berry pokeapi.BerryInfo) string {
+	if conf.lang == "" || conf.lang == defaultLang {
+		return berry.Name
+	}
+	names, err := fetchNames(conf, "item", berry.Item.Name)
+	if err != nil {
+		return berry.Name
+	}
+	return localizedName(conf, names, berry.Name)
+}
+
+func commandBerry(conf *config, args ...string) error {
+	if len(args) == 0 {
+		fmt.Println("please provide a berry name, e.g. `berry cheri`")
+		return nil
+	}
+	name, ok := resolveName(conf, "berry", args[0])
+	if !ok {
+		return nil
+	}
+	url := "https://pokeapi.co/api/v2/berry/" + name + "/"
+	berryInfoRes, err := pokeapi.GetBerryInfo(url, conf.cache)
+	if err != nil {
+		fmt.Println("error in GetBerryInfo: %w", err)
+		return fmt.Errorf("error in GetBerryInfo: %w", err)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "Name:\t%s\n", berryDisplayName(conf, berryInfoRes))
+	fmt.Fprintf(w, "Firmness:\t%s\n", berryInfoRes.Firmness.Name)
+	fmt.Fprintf(w, "Growth Time:\t%dh per stage\n", berryInfoRes.GrowthTime)
+	fmt.Fprintf(w, "Max Harvest:\t%d\n", berryInfoRes.MaxHarvest)
+	fmt.Fprintf(w, "Size:\t%dmm\n", berryInfoRes.Size)
+	fmt.Fprintf(w, "Smoothness:\t%d\n", berryInfoRes.Smoothness)
+	fmt.Fprintf(w, "Natural Gift:\t%s %d\n", berryInfoRes.NaturalGiftType.Name, berryInfoRes.NaturalGiftPower)
+	fmt.Fprintf(w, "Flavors:\t\n")
+	for _, flavor := range berryInfoRes.Flavors {
+		fmt.Fprintf(w, "  %s\t%d\n", flavor.Flavor.Name, flavor.Potency)
+	}
+	return w.Flush()
+}
+
+func commandBerries(conf *config, args ...string) error {
+	_, flags := parseFlags(args)
+	flavor := flags["flavor"]
+	sortBy := flags["sort"]
+	if sortBy == "" {
+		sortBy = "name"
+	}
+	less := map[string]func(a, b berryRow) bool{
+		"name":       func(a, b berryRow) bool { return a.berry.Name < b.berry.Name },
+		"potency":    func(a, b berryRow) bool { return a.potency > b.potency },
+		"growth":     func(a, b berryRow) bool { return a.berry.GrowthTime < b.berry.GrowthTime },
+		"harvest":    func(a, b berryRow) bool { return a.berry.MaxHarvest > b.berry.MaxHarvest },
+		"size":       func(a, b berryRow) bool { return a.berry.Size < b.berry.Size },
+		"smoothness": func(a, b berryRow) bool { return a.berry.Smoothness < b.berry.Smoothness },
+		"power":      func(a, b berryRow) bool { return a.berry.NaturalGiftPower > b.berry.NaturalGiftPower },
+	}
+	lessFn, ok := less[sortBy]
+	if !ok {
+		fmt.Println("--sort must be one of name, potency, growth, harvest, size, smoothness, power")
+		return nil
+	}
+	if flavor != "" {
+		flavor, ok = resolveName(conf, "berry-flavor", flavor)
+		if !ok {
+			return nil
+		}
+	}
+	if sortBy == "potency" && flavor == "" {
+		fmt.Println("--sort potency needs a --flavor")
+		return nil
+	}
+
+	rows := []berryRow{}
+	if flavor != "" {
+		url := "https://pokeapi.co/api/v2/berry-flavor/" + flavor + "/"
+		berryFlavorInfoRes, err := pokeapi.GetBerryFlavorInfo(url, conf.cache)
+		if err != nil {
+			fmt.Println("error in GetBerryFlavorInfo: %w", err)
+			return fmt.Errorf("error in GetBerryFlavorInfo: %w", err)
+		}
+		for _, flavorBerry := range berryFlavorInfoRes.Berries {
+			if flavorBerry.Potency == 0 {
+				continue
+			}
+			berryInfoRes, err := pokeapi.GetBerryInfo(flavorBerry.Berry.Url, conf.cache)
+			if err != nil {
+				fmt.Println("error in GetBerryInfo: %w", err)
+				return fmt.Errorf("error in GetBerryInfo: %w", err)
+			}
+			rows = append(rows, berryRow{berry: berryInfoRes, potency: flavorBerry.Potency})
+		}
+	} else {
+		url := "https://pokeapi.co/api/v2/berry/?limit=100"
+		berryListRes, err := pokeapi.GetResourceList(url, conf.cache)
+		if err != nil {
+			fmt.Println("error in GetResourceList: %w", err)
+			return fmt.Errorf("error in GetResourceList: %w", err)
+		}
+		for _, berry := range berryListRes.Results {
+			berryInfoRes, err := pokeapi.GetBerryInfo(berry.Url, conf.cache)
+			if err != nil {
+				fmt.Println("error in GetBerryInfo: %w", err)
+				return fmt.Errorf("error in GetBerryInfo: %w", err)
+			}
+			rows = append(rows, berryRow{berry: berryInfoRes})
+		}
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool { return lessFn(rows[i], rows[j]) })
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	header := "NAME\tGROWTH\tHARVEST\tSIZE\tSMOOTHNESS\tGIFT TYPE\tGIFT POWER"
+	if flavor != "" {
+		header += "\t" + strings.ToUpper(flavor)
+	}
+	fmt.Fprintln(w, header)
+	for _, row := range rows {
+		line := fmt.Sprintf("%s\t%dh\t%d\t%dmm\t%d\t%s\t%d",
+			berryDisplayName(conf, row.berry),
+			row.berry.GrowthTime,
+			row.berry.MaxHarvest,
+			row.berry.Size,
+			row.berry.Smoothness,
+			row.berry.NaturalGiftType.Name,
+			row.berry.NaturalGiftPower,
+		)
+		if flavor != "" {
+			line += fmt.Sprintf("\t%d", row.potency)
+		}
+		fmt.Fprintln(w, line)
+	}
+	return w.Flush()
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+// boxUid parses the id of a pokemon in the box, printing why when it isn't one.
+func boxUid(conf *config, arg string) (int, bool) {
+	uid, err := strconv.Atoi(arg)
+	if err != nil {
+		fmt.Printf("%s is not a box id, see `box`\n", arg)
+		return 0, false
+	}
+	if _, ok := conf.pokedex.Get(uid); !ok {
+		fmt.Printf("there is no pokemon #%d in your box\n", uid)
+		return 0, false
+	}
+	return uid, true
+}
+
+func commandBox(conf *config, args ...string) error {
+	if conf.pokedex.Len() == 0 {
+		fmt.Println("Your box is empty. Catch some pokemon first!")
+		return nil
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tNAME\tNICKNAME\tLEVEL\tCAUGHT")
+	for _, record := range conf.pokedex.All() {
+		level := "?"
+		if record.Level > 0 {
+			level = strconv.Itoa(record.Level)
+		}
+		caughtAt := "unknown"
+		if !record.CaughtAt.IsZero() {
+			caughtAt = record.CaughtAt.Local().Format("2006-01-02 15:04")
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\n", record.Uid, pokemonDisplayName(conf, record.Name), record.Nickname, level, caughtAt)
+	}
+	return w.Flush()
+}
+
+// commandRelease removes a pokemon from the box. Its species stays caught in
+// the pokedex.
+func commandRelease(conf *config, args ...string) error {
+	if len(args) == 0 {
+		fmt.Println("please provide the id of the pokemon to release, see `box`")
+		return nil
+	}
+	uid, ok := boxUid(conf, args[0])
+	if !ok {
+		return nil
+	}
+	record, _ := conf.pokedex.Get(uid)
+	if err := conf.pokedex.Delete(uid); err != nil {
+		fmt.Println(err)
+		return nil
+	}
+	if slot := slices.Index(conf.party, uid); slot >= 0 {
+		conf.party, _ = partyRemove(conf.party, slot+1)
+		if err := writeProfile(conf); err != nil {
+			fmt.Println(err)
+		}
+	}
+	fmt.Printf("%s was released. Bye, %s!\n", pokemonDisplayName(conf, record.Name), record.DisplayName())
+	return nil
+}
+
+func commandNickname(conf *config, args ...string) error {
+	if len(args) < 2 {
+		fmt.Println("usage: nickname <id> <name>")
+		return nil
+	}
+	uid, ok := boxUid(conf, args[0])
+	if !ok {
+		return nil
+	}
+	record, _ := conf.pokedex.Get(uid)
+	record.Nickname = strings.Join(args[1:], " ")
+	if nickname := rawTail(conf.input, 2); nickname != "" {
+		record.Nickname = nickname
+	}
+	if _, err := conf.pokedex.Put(record); err != nil {
+		fmt.Println(err)
+		return nil
+	}
+	fmt.Printf("#%d %s is now called %s\n", uid, record.Name, record.Nickname)
+	return nil
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/4mewes/pokedex/internal/pokeapi"
+)
+
+const dexPageSize = 20
+
+func commandDex(conf *config, args ...string) error {
+	args, flags := parseFlags(args)
+	if len(args) == 0 {
+		fmt.Println("please provide a pokedex name, e.g. `dex national` or `dex kanto`")
+		return nil
+	}
+	page := 1
+	if pageArg, ok := flags["page"]; ok {
+		var err error
+		page, err = strconv.Atoi(pageArg)
+		if err != nil || page < 1 {
+			fmt.Println("--page must be a positive number")
+			return nil
+		}
+	}
+
+	name, ok := resolveName(conf, "pokedex", args[0])
+	if !ok {
+		return nil
+	}
+	url := "https://pokeapi.co/api/v2/pokedex/" + name + "/"
+	pokedexInfoRes, err := pokeapi.GetPokedexInfo(url, conf.cache)
+	if err != nil {
+		fmt.Println("error in GetPokedexInfo: %w", err)
+		return fmt.Errorf("error in GetPokedexInfo: %w", err)
+	}
+
+	entries := pokedexInfoRes.PokemonEntries
+	pages := (len(entries) + dexPageSize - 1) / dexPageSize
+	if page > pages {
+		fmt.Printf("%s only has %d pages\n", pokedexInfoRes.Name, pages)
+		return nil
+	}
+
+	caught := conf.caught
+	caughtCount := 0
+	for _, entry := range entries {
+		if caught[entry.PokemonSpecies.Name] {
+			caughtCount++
+		}
+	}
+
+	start := (page - 1) * dexPageSize
+	end := min(start+dexPageSize, len(entries))
+	for _, entry := range entries[start:end] {
+		mark := " "
+		if caught[entry.PokemonSpecies.Name] {
+			mark = "x"
+		}
+		fmt.Printf("#%03d [%s] %s\n", entry.EntryNumber, mark, pokemonDisplayName(conf, entry.PokemonSpecies.Name))
+	}
+	fmt.Printf("Page %d/%d - caught %d/%d\n", page, pages, caughtCount, len(entries))
+	return nil
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/4mewes/pokedex/internal/pokeapi"
+)
+
+func typeNames(types []pokeapi.Types) string {
+	names := []string{}
+	for _, pokemonType := range types {
+		names = append(names, pokemonType.Type.Name)
+	}
+	return strings.Join(names, "/")
+}
+
+func commandForms(conf *config, args ...string) error {
+	if len(args) == 0 {
+		fmt.Println("please provide a species name, e.g. `forms vulpix`")
+		return nil
+	}
+	speciesName, ok := resolveSpeciesName(conf, args[0])
+	if !ok {
+		return nil
+	}
+	url := "https://pokeapi.co/api/v2/pokemon-species/" + speciesName + "/"
+	pokemonSpeciesInfoRes, err := pokeapi.GetPokemonSpeciesInfo(url, conf.cache)
+	if err != nil {
+		fmt.Println("error in GetPokemonSpeciesInfo: %w", err)
+		return fmt.Errorf("error in GetPokemonSpeciesInfo: %w", err)
+	}
+
+	fmt.Printf("Varieties of %s:\n", pokemonDisplayName(conf, pokemonSpeciesInfoRes.Name))
+	for _, variety := range pokemonSpeciesInfoRes.Varieties {
+		pokemonInfoRes, err := pokeapi.GetPokemonInfo(variety.Pokemon.Url, conf.cache)
+		if err != nil {
+			fmt.Println("error in GetPokemonInfo: %w", err)
+			return fmt.Errorf("error in GetPokemonInfo: %w", err)
+		}
+		marker := ""
+		if variety.IsDefault {
+			marker = " (default)"
+		}
+		fmt.Printf("- %s%s: %s\n", pokemonInfoRes.Name, marker, typeNames(pokemonInfoRes.Types))
+
+		for _, form := range pokemonInfoRes.Forms {
+			if form.Name == pokemonInfoRes.Name {
+				continue
+			}
+			pokemonFormInfoRes, err := pokeapi.GetPokemonFormInfo(form.Url, conf.cache)
+			if err != nil {
+				fmt.Println("error in GetPokemonFormInfo: %w", err)
+				return fmt.Errorf("error in GetPokemonFormInfo: %w", err)
+			}
+			flags := []string{}
+			if pokemonFormInfoRes.IsMega {
+				flags = append(flags, "mega")
+			}
+			if pokemonFormInfoRes.IsBattleOnly {
+				flags = append(flags, "battle only")
+			}
+			line := fmt.Sprintf("    form %s: %s", pokemonFormInfoRes.Name, typeNames(pokemonFormInfoRes.Types))
+			if len(flags) > 0 {
+				line += " (" + strings.Join(flags, ", ") + ")"
+			}
+			fmt.Println(line)
+		}
+	}
+	return nil
+}
+
+// printVarietyDifferences shows how a non-default variety like vulpix-alola
+// differs from its species' default pokemon.
+func printVarietyDifferences(conf *config, pokemon pokeapi.PokemonInfo) error {
+	if pokemon.IsDefault || pokemon.Species.Url == "" {
+		return nil
+	}
+	pokemonSpeciesInfoRes, err := pokeapi.GetPokemonSpeciesInfo(pokemon.Species.Url, conf.cache)
+	if err != nil {
+		return fmt.Errorf("error in GetPokemonSpeciesInfo: %w", err)
+	}
+	defaultUrl := ""
+	for _, variety := range pokemonSpeciesInfoRes.Varieties {
+		if variety.IsDefault {
+			defaultUrl = variety.Pokemon.Url
+		}
+	}
+	if defaultUrl == "" {
+		return nil
+	}
+	defaultPokemon, err := pokeapi.GetPokemonInfo(defaultUrl, conf.cache)
+	if err != nil {
+		return fmt.Errorf("error in GetPokemonInfo: %w", err)
+	}
+
+	fmt.Printf("Differences from %s:\n", defaultPokemon.Name)
+	if typeNames(pokemon.Types) != typeNames(defaultPokemon.Types) {
+		fmt.Printf("  - types: %s -> %s\n", typeNames(defaultPokemon.Types), typeNames(pokemon.Types))
+	}
+	defaultStats := make(map[string]int)
+	for _, stat := range defaultPokemon.Stats {
+		defaultStats[stat.Stat.Name] = stat.BaseStat
+	}
+	for _, stat := range pokemon.Stats {
+		if defaultStat := defaultStats[stat.Stat.Name]; defaultStat != stat.BaseStat {
+			fmt.Printf("  - %s: %d -> %d (%+d)\n", stat.Stat.Name, defaultStat, stat.BaseStat, stat.BaseStat-defaultStat)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,227 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/4mewes/pokedex/internal/pokeapi"
+)
+
+func commandGame(conf *config, args ...string) error {
+	if len(args) == 0 {
+		if conf.game == "" {
+			fmt.Println("No game selected. Use `game <version>`, e.g. `game red`.")
+			return nil
+		}
+		fmt.Printf("Playing pokemon %s (%s, %s)\n", conf.game, conf.versionGroup, conf.generation)
+		return nil
+	}
+	if args[0] == "none" {
+		conf.game = ""
+		conf.versionGroup = ""
+		conf.generation = ""
+		fmt.Println("Game cleared, lookups cover every game again.")
+		if err := writeProfile(conf); err != nil {
+			fmt.Println(err)
+		}
+		return nil
+	}
+
+	name, ok := resolveName(conf, "version", args[0])
+	if !ok {
+		return nil
+	}
+	url := "https://pokeapi.co/api/v2/version/" + name + "/"
+	versionInfoRes, err := pokeapi.GetVersionInfo(url, conf.cache)
+	if err != nil {
+		fmt.Println("error in GetVersionInfo: %w", err)
+		return fmt.Errorf("error in GetVersionInfo: %w", err)
+	}
+	versionGroupInfoRes, err := pokeapi.GetVersionGroupInfo(versionInfoRes.VersionGroup.Url, conf.cache)
+	if err != nil {
+		fmt.Println("error in GetVersionGroupInfo: %w", err)
+		return fmt.Errorf("error in GetVersionGroupInfo: %w", err)
+	}
+	generationInfoRes, err := pokeapi.GetGenerationInfo(versionGroupInfoRes.Generation.Url, conf.cache)
+	if err != nil {
+		fmt.Println("error in GetGenerationInfo: %w", err)
+		return fmt.Errorf("error in GetGenerationInfo: %w", err)
+	}
+
+	conf.game = versionInfoRes.Name
+	conf.versionGroup = versionGroupInfoRes.Name
+	conf.generation = generationInfoRes.Name
+
+	regions := []string{}
+	for _, region := range versionGroupInfoRes.Regions {
+		regions = append(regions, region.Name)
+	}
+	fmt.Printf("Now playing pokemon %s (%s, %s)\n", localizedName(conf, versionInfoRes.Names, conf.game), conf.versionGroup, conf.generation)
+	if len(regions) > 0 {
+		fmt.Printf("Regions: %s\n", strings.Join(regions, ", "))
+	}
+	fmt.Printf("Species introduced in %s: %d\n", generationInfoRes.Name, len(generationInfoRes.PokemonSpecies))
+	if err := writeProfile(conf); err != nil {
+		fmt.Println(err)
+	}
+	return nil
+}
+
+// spriteForGame looks up the front sprite of the selected game in the
+// per-generation sprite sets, falling back to the default sprite.
+func spriteForGame(conf *config, sprites pokeapi.Sprites) string {
+	if conf.generation == "" {
+		return sprites.FrontDefault
+	}
+	raw, err := json.Marshal(sprites.Versions)
+	if err != nil {
+		return sprites.FrontDefault
+	}
+	generations := map[string]map[string]json.RawMessage{}
+	if err := json.Unmarshal(raw, &generations); err != nil {
+		return sprites.FrontDefault
+	}
+	gameSprites, ok := generations[conf.generation][conf.versionGroup]
+	if !ok {
+		gameSprites, ok = generations[conf.generation][conf.game]
+	}
+	if !ok {
+		return sprites.FrontDefault
+	}
+	var front struct {
+		FrontDefault string `json:"front_default"`
+	}
+	if err := json.Unmarshal(gameSprites, &front); err != nil || front.FrontDefault == "" {
+		return sprites.FrontDefault
+	}
+	return front.FrontDefault
+}
+
+// flavorTextForGame returns the flavor text of the selected game's version
+// group, or the most recent one when no game is selected. It prefers
+// conf.lang and falls back to english.
+func flavorTextForGame(conf *config, entries []pokeapi.FlavorTextEntries) string {
+	texts := make(map[string]string)
+	for _, entry := range entries {
+		if conf.versionGroup == "" || entry.VersionGroup.Name == conf.versionGroup {
+			texts[entry.Language.Name] = entry.FlavorText
+		}
+	}
+	text, ok := texts[conf.lang]
+	if !ok {
+		text = texts[defaultLang]
+	}
+	return strings.Join(strings.Fields(text), " ")
+}
+
+func itemFlavorTextForGame(conf *config, entries []pokeapi.ItemFlavorTextEntries) string {
+	flavorTextEntries := []pokeapi.FlavorTextEntries{}
+	for _, entry := range entries {
+		flavorTextEntries = append(flavorTextEntries, pokeapi.FlavorTextEntries{
+			FlavorText:   entry.Text,
+			Language:     entry.Language,
+			VersionGroup: entry.VersionGroup,
+		})
+	}
+	return flavorTextForGame(conf, flavorTextEntries)
+}
+
+type learnedMove struct {
+	name    string
+	url     string
+	method  string
+	level   int
+	machine string
+}
+
+func commandMoves(conf *config, args ...string) error {
+	args, flags := parseFlags(args)
+	if len(args) == 0 {
+		fmt.Println("please provide a pokemon name")
+		return nil
+	}
+	versionGroup := flags["version-group"]
+	if versionGroup == "" {
+		versionGroup = conf.versionGroup
+	}
+
+	name, ok := resolvePokemonName(conf, args[0])
+	if !ok {
+		return nil
+	}
+	url := "https://pokeapi.co/api/v2/pokemon/" + name + "/"
+	pokemonInfoRes, err := pokeapi.GetPokemonInfo(url, conf.cache)
+	if err != nil {
+		fmt.Println("error in GetPokemonInfo: %w", err)
+		return fmt.Errorf("error in GetPokemonInfo: %w", err)
+	}
+
+	if versionGroup == "" {
+		fmt.Println("No game selected (see `game`), listing every move across all games:")
+		for _, move := range pokemonInfoRes.Moves {
+			fmt.Printf("- %s\n", move.Move.Name)
+		}
+		return nil
+	}
+
+	learnset := []learnedMove{}
+	for _, move := range pokemonInfoRes.Moves {
+		for _, details := range move.VersionGroupDetails {
+			if details.VersionGroup.Name != versionGroup {
+				continue
+			}
+			learnset = append(learnset, learnedMove{
+				name:   move.Move.Name,
+				url:    move.Move.Url,
+				method: details.MoveLearnMethod.Name,
+				level:  details.LevelLearnedAt,
+			})
+		}
+	}
+	for i := range learnset {
+		if learnset[i].method != "machine" {
+			continue
+		}
+		learnset[i].machine, err = machineForMove(conf, learnset[i].url, versionGroup)
+		if err != nil {
+			fmt.Println("error in machineForMove: %w", err)
+			return fmt.Errorf("error in machineForMove: %w", err)
+		}
+	}
+	if len(learnset) == 0 {
+		fmt.Printf("%s learns no moves in %s\n", pokemonInfoRes.Name, versionGroup)
+		return nil
+	}
+	sort.SliceStable(learnset, func(i, j int) bool {
+		if learnset[i].method != learnset[j].method {
+			return learnset[i].method < learnset[j].method
+		}
+		if learnset[i].level != learnset[j].level {
+			return learnset[i].level < learnset[j].level
+		}
+		return learnset[i].name < learnset[j].name
+	})
+
+	fmt.Printf("Moves of %s in %s:\n", pokemonInfoRes.Name, versionGroup)
+	for i := range learnset {
+		if conf.lang == defaultLang {
+			break
+		}
+		moveInfoRes, err := pokeapi.GetMoveInfo(learnset[i].url, conf.cache)
+		if err == nil {
+			learnset[i].name = localizedName(conf, moveInfoRes.Names, learnset[i].name)
+		}
+	}
+	for _, move := range learnset {
+		if move.method == "level-up" {
+			fmt.Printf("- %s (lv %d)\n", move.name, move.level)
+		} else if move.machine != "" {
+			fmt.Printf("- %s (%s)\n", move.name, move.machine)
+		} else {
+			fmt.Printf("- %s (%s)\n", move.name, move.method)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"fmt"
+	"path"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/4mewes/pokedex/internal/pokeapi"
+)
+
+func abilityNames(abilities []pokeapi.Abilities) string {
+	names := []string{}
+	for _, ability := range abilities {
+		if ability.Ability.Name == "" {
+			continue
+		}
+		if ability.IsHidden {
+			names = append(names, ability.Ability.Name+" (hidden)")
+		} else {
+			names = append(names, ability.Ability.Name)
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+// generationNumber reads a generation's number from its url, e.g. 4 for
+// https://pokeapi.co/api/v2/generation/4/.
+func generationNumber(generation pokeapi.Generation) int {
+	number, err := strconv.Atoi(path.Base(strings.TrimSuffix(generation.Url, "/")))
+	if err != nil {
+		return 0
+	}
+	return number
+}
+
+// pastAbilitySet rebuilds the full ability list of a past generation. Past
+// entries only list the slots that changed after their generation, with no
+// ability for slots that did not exist back then. Every entry at or after
+// the generation applies, newest first, so the closest one wins.
+func pastAbilitySet(current []pokeapi.Abilities, past []pokeapi.PastAbilities, generation int) []pokeapi.Abilities {
+	bySlot := make(map[int]pokeapi.Abilities)
+	for _, ability := range current {
+		bySlot[ability.Slot] = ability
+	}
+	newestFirst := slices.Clone(past)
+	sort.SliceStable(newestFirst, func(i, j int) bool {
+		return generationNumber(newestFirst[i].Generation) > generationNumber(newestFirst[j].Generation)
+	})
+	for _, pastAbilities := range newestFirst {
+		if generationNumber(pastAbilities.Generation) < generation {
+			break
+		}
+		for _, ability := range pastAbilities.Abilities {
+			bySlot[ability.Slot] = ability
+		}
+	}
+	abilities := []pokeapi.Abilities{}
+	for slot := 1; slot <= 3; slot++ {
+		if ability, ok := bySlot[slot]; ok {
+			abilities = append(abilities, ability)
+		}
+	}
+	return abilities
+}
+
+// commandHistory shows how a pokemon's typing and abilities changed. Past
+// entries in pokeapi hold what the pokemon had up to and including the
+// listed generation.
+func commandHistory(conf *config, args ...string) error {
+	if len(args) == 0 {
+		fmt.Println("please provide a pokemon name")
+		return nil
+	}
+	name, ok := resolvePokemonName(conf, args[0])
+	if !ok {
+		return nil
+	}
+	url := "https://pokeapi.co/api/v2/pokemon/" + name + "/"
+	pokemonInfoRes, err := pokeapi.GetPokemonInfo(url, conf.cache)
+	if err != nil {
+		fmt.Println("error in GetPokemonInfo: %w", err)
+		return fmt.Errorf("error in GetPokemonInfo: %w", err)
+	}
+
+	fmt.Printf("Type history of %s:\n", pokemonDisplayName(conf, pokemonInfoRes.Name))
+	for _, pastTypes := range pokemonInfoRes.PastTypes {
+		fmt.Printf("  up to %s: %s\n", pastTypes.Generation.Name, typeNames(pastTypes.Types))
+	}
+	fmt.Printf("  now: %s\n", typeNames(pokemonInfoRes.Types))
+
+	fmt.Printf("Ability history of %s:\n", pokemonDisplayName(conf, pokemonInfoRes.Name))
+	for _, pastAbilities := range pokemonInfoRes.PastAbilities {
+		fmt.Printf("  up to %s: %s\n", pastAbilities.Generation.Name, abilityNames(pastAbilitySet(pokemonInfoRes.Abilities, pokemonInfoRes.PastAbilities, generationNumber(pastAbilities.Generation))))
+	}
+	fmt.Printf("  now: %s\n", abilityNames(pokemonInfoRes.Abilities))
+	return nil
+}
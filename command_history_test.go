@@ -0,0 +1,54 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/4mewes/pokedex/internal/pokeapi"
+)
+
+func TestGenerationNumber(t *testing.T) {
+	cases := map[string]int{
+		"https://pokeapi.co/api/v2/generation/4/": 4,
+		"https://pokeapi.co/api/v2/generation/9":  9,
+		"":                                        0,
+	}
+	for url, expected := range cases {
+		if actual := generationNumber(pokeapi.Generation{Url: url}); actual != expected {
+			t.Errorf("generationNumber(%q) = %d, expected %d", url, actual, expected)
+		}
+	}
+}
+
+func TestPastAbilitySet(t *testing.T) {
+	ability := func(name string, slot int) pokeapi.Abilities {
+		return pokeapi.Abilities{Ability: pokeapi.Ability{Name: name}, Slot: slot}
+	}
+	generation := func(number string) pokeapi.Generation {
+		return pokeapi.Generation{Url: "https://pokeapi.co/api/v2/generation/" + number + "/"}
+	}
+	current := []pokeapi.Abilities{ability("a-now", 1), ability("b-now", 2), ability("hidden-now", 3)}
+	// slot 2 changed after gen 4 and slot 1 after gen 6, listed newest first
+	// to make sure the order of the api response doesn't matter
+	past := []pokeapi.PastAbilities{
+		{Generation: generation("6"), Abilities: []pokeapi.Abilities{ability("a-gen6", 1)}},
+		{Generation: generation("4"), Abilities: []pokeapi.Abilities{ability("b-gen4", 2), ability("", 3)}},
+	}
+
+	cases := []struct {
+		generation int
+		expected   string
+	}{
+		{generation: 3, expected: "a-gen6, b-gen4"},
+		{generation: 4, expected: "a-gen6, b-gen4"},
+		{generation: 5, expected: "a-gen6, b-now, hidden-now"},
+		{generation: 6, expected: "a-gen6, b-now, hidden-now"},
+		{generation: 7, expected: "a-now, b-now, hidden-now"},
+	}
+
+	for _, c := range cases {
+		actual := abilityNames(pastAbilitySet(current, past, c.generation))
+		if actual != c.expected {
+			t.Errorf("generation %d: expected %q, got %q", c.generation, c.expected, actual)
+		}
+	}
+}
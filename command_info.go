@@ -0,0 +1,154 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+
+	"github.com/4mewes/pokedex/internal/pokeapi"
+)
+
+// resolvePokemonName accepts a pokemon name or national dex id and returns
+// the pokemon's api name.
+func resolvePokemonName(conf *config, input string) (string, bool) {
+	id, err := strconv.Atoi(input)
+	if err != nil {
+		return resolveName(conf, "pokemon", input)
+	}
+	url := "https://pokeapi.co/api/v2/pokemon/" + strconv.Itoa(id) + "/"
+	pokemonInfoRes, err := pokeapi.GetPokemonInfo(url, conf.cache)
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		fmt.Printf("there is no pokemon with id %d\n", id)
+		return "", false
+	}
+	if err != nil {
+		fmt.Println("error in GetPokemonInfo: %w", err)
+		return "", false
+	}
+	return pokemonInfoRes.Name, true
+}
+
+// resolveSpeciesName is resolvePokemonName for species, which share their
+// national dex ids with their default pokemon.
+func resolveSpeciesName(conf *config, input string) (string, bool) {
+	id, err := strconv.Atoi(input)
+	if err != nil {
+		return resolveName(conf, "pokemon-species", input)
+	}
+	url := "https://pokeapi.co/api/v2/pokemon-species/" + strconv.Itoa(id) + "/"
+	pokemonSpeciesInfoRes, err := pokeapi.GetPokemonSpeciesInfo(url, conf.cache)
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		fmt.Printf("there is no pokemon species with id %d\n", id)
+		return "", false
+	}
+	if err != nil {
+		fmt.Println("error in GetPokemonSpeciesInfo: %w", err)
+		return "", false
+	}
+	return pokemonSpeciesInfoRes.Name, true
+}
+
+// parseIDRange parses "1-151" into its bounds.
+func parseIDRange(input string) (int, int, bool) {
+	from, to, ok := strings.Cut(input, "-")
+	if !ok {
+		return 0, 0, false
+	}
+	start, err := strconv.Atoi(from)
+	if err != nil {
+		return 0, 0, false
+	}
+	end, err := strconv.Atoi(to)
+	if err != nil || start < 1 || end < start {
+		return 0, 0, false
+	}
+	return start, end, true
+}
+
+func printPokemonSummary(conf *config, pokemon pokeapi.PokemonInfo) {
+	total := 0
+	for _, stat := range pokemon.Stats {
+		total += stat.BaseStat
+	}
+	fmt.Printf("#%03d %s - %s - BST %d\n", pokemon.Id, pokemonDisplayName(conf, pokemon.Name), typeNames(pokemon.Types), total)
+}
+
+func commandInfo(conf *config, args ...string) error {
+	if len(args) == 0 {
+		fmt.Println("please provide a pokemon name, id or id range like 1-151")
+		return nil
+	}
+
+	urls := []string{}
+	if start, end, ok := parseIDRange(args[0]); ok {
+		for id := start; id <= end; id++ {
+			urls = append(urls, "https://pokeapi.co/api/v2/pokemon/"+strconv.Itoa(id)+"/")
+		}
+	} else {
+		name, ok := resolvePokemonName(conf, args[0])
+		if !ok {
+			return nil
+		}
+		urls = append(urls, "https://pokeapi.co/api/v2/pokemon/"+name+"/")
+	}
+
+	for _, url := range urls {
+		pokemonInfoRes, err := pokeapi.GetPokemonInfo(url, conf.cache)
+		if errors.Is(err, pokeapi.ErrNotFound) {
+			// ranges may run past the last pokemon
+			break
+		}
+		if err != nil {
+			fmt.Println("error in GetPokemonInfo: %w", err)
+			return fmt.Errorf("error in GetPokemonInfo: %w", err)
+		}
+		printPokemonSummary(conf, pokemonInfoRes)
+	}
+	return nil
+}
+
+func commandRandom(conf *config, args ...string) error {
+	_, flags := parseFlags(args)
+
+	var url string
+	if gen, ok := flags["gen"]; ok {
+		generationInfoRes, err := pokeapi.GetGenerationInfo("https://pokeapi.co/api/v2/generation/"+gen+"/", conf.cache)
+		if errors.Is(err, pokeapi.ErrNotFound) {
+			fmt.Printf("there is no generation %s\n", gen)
+			return nil
+		}
+		if err != nil {
+			fmt.Println("error in GetGenerationInfo: %w", err)
+			return fmt.Errorf("error in GetGenerationInfo: %w", err)
+		}
+		species := generationInfoRes.PokemonSpecies[rand.Intn(len(generationInfoRes.PokemonSpecies))]
+		pokemonSpeciesInfoRes, err := pokeapi.GetPokemonSpeciesInfo(species.Url, conf.cache)
+		if err != nil {
+			fmt.Println("error in GetPokemonSpeciesInfo: %w", err)
+			return fmt.Errorf("error in GetPokemonSpeciesInfo: %w", err)
+		}
+		for _, variety := range pokemonSpeciesInfoRes.Varieties {
+			if variety.IsDefault {
+				url = variety.Pokemon.Url
+			}
+		}
+	} else {
+		speciesListRes, err := pokeapi.GetResourceList("https://pokeapi.co/api/v2/pokemon-species/?limit=1", conf.cache)
+		if err != nil {
+			fmt.Println("error in GetResourceList: %w", err)
+			return fmt.Errorf("error in GetResourceList: %w", err)
+		}
+		// default varieties share their species' id
+		url = "https://pokeapi.co/api/v2/pokemon/" + strconv.Itoa(rand.Intn(speciesListRes.Count)+1) + "/"
+	}
+
+	pokemonInfoRes, err := pokeapi.GetPokemonInfo(url, conf.cache)
+	if err != nil {
+		fmt.Println("error in GetPokemonInfo: %w", err)
+		return fmt.Errorf("error in GetPokemonInfo: %w", err)
+	}
+	printPokemonSummary(conf, pokemonInfoRes)
+	return nil
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/4mewes/pokedex/internal/pokeapi"
+)
+
+func commandItem(conf *config, args ...string) error {
+	if len(args) == 0 {
+		fmt.Println("please provide an item name")
+		return nil
+	}
+	name, ok := resolveName(conf, "item", args[0])
+	if !ok {
+		return nil
+	}
+	url := "https://pokeapi.co/api/v2/item/" + name + "/"
+	itemInfoRes, err := pokeapi.GetItemInfo(url, conf.cache)
+	if err != nil {
+		fmt.Println("error in GetItemInfo: %w", err)
+		return fmt.Errorf("error in GetItemInfo: %w", err)
+	}
+
+	fmt.Printf("Item: %s\n", localizedName(conf, itemInfoRes.Names, itemInfoRes.Name))
+	fmt.Printf("Category: %s\n", itemInfoRes.Category.Name)
+	fmt.Printf("Cost: %d\n", itemInfoRes.Cost)
+	if itemInfoRes.FlingPower > 0 {
+		fmt.Printf("Fling Power: %d\n", itemInfoRes.FlingPower)
+	}
+	if itemInfoRes.FlingEffect.Name != "" {
+		fmt.Printf("Fling Effect: %s\n", itemInfoRes.FlingEffect.Name)
+	}
+	if len(itemInfoRes.Attributes) > 0 {
+		attributes := []string{}
+		for _, attribute := range itemInfoRes.Attributes {
+			attributes = append(attributes, attribute.Name)
+		}
+		fmt.Printf("Attributes: %s\n", strings.Join(attributes, ", "))
+	}
+	if effect := localizedEffect(conf, itemInfoRes.EffectEntries); effect != "" {
+		fmt.Printf("Effect: %s\n", effect)
+	}
+	if flavorText := itemFlavorTextForGame(conf, itemInfoRes.FlavorTextEntries); flavorText != "" {
+		fmt.Printf("Description: %s\n", flavorText)
+	}
+	if itemInfoRes.Sprites.Default != "" {
+		fmt.Printf("Sprite: %s\n", itemInfoRes.Sprites.Default)
+	}
+	return nil
+}
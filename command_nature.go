@@ -0,0 +1,35 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/4mewes/pokedex/internal/pokeapi"
+)
+
+func commandNature(conf *config, args ...string) error {
+	if len(args) == 0 {
+		fmt.Println("please provide a nature name, e.g. `nature adamant`")
+		return nil
+	}
+	name, ok := resolveName(conf, "nature", args[0])
+	if !ok {
+		return nil
+	}
+	url := "https://pokeapi.co/api/v2/nature/" + name + "/"
+	natureInfoRes, err := pokeapi.GetNatureInfo(url, conf.cache)
+	if err != nil {
+		fmt.Println("error in GetNatureInfo: %w", err)
+		return fmt.Errorf("error in GetNatureInfo: %w", err)
+	}
+
+	fmt.Printf("Nature: %s\n", localizedName(conf, natureInfoRes.Names, natureInfoRes.Name))
+	if natureInfoRes.IncreasedStat.Name == "" || natureInfoRes.IncreasedStat.Name == natureInfoRes.DecreasedStat.Name {
+		fmt.Println("This nature is neutral, it does not change any stats.")
+		return nil
+	}
+	fmt.Printf("Increased Stat: %s (+10%%)\n", natureInfoRes.IncreasedStat.Name)
+	fmt.Printf("Decreased Stat: %s (-10%%)\n", natureInfoRes.DecreasedStat.Name)
+	fmt.Printf("Likes Flavor: %s\n", natureInfoRes.LikesFlavor.Name)
+	fmt.Printf("Hates Flavor: %s\n", natureInfoRes.HatesFlavor.Name)
+	return nil
+}
@@ -0,0 +1,252 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/4mewes/pokedex/internal/pokeapi"
+)
+
+const maxPartySize = 6
+
+var (
+	errPartyFull    = errors.New("your party is full, remove a pokemon first")
+	errAlreadyParty = errors.New("that pokemon is already in your party")
+	errNoSuchSlot   = errors.New("there is no pokemon in that party slot")
+)
+
+// partyAdd appends a box uid to the party.
+func partyAdd(party []int, uid int) ([]int, error) {
+	if slices.Contains(party, uid) {
+		return party, errAlreadyParty
+	}
+	if len(party) >= maxPartySize {
+		return party, errPartyFull
+	}
+	return append(party, uid), nil
+}
+
+// partyRemove takes the pokemon in a party slot, counted from 1, out of the
+// party. The pokemon behind it move up.
+func partyRemove(party []int, slot int) ([]int, error) {
+	if slot < 1 || slot > len(party) {
+		return party, errNoSuchSlot
+	}
+	return slices.Delete(slices.Clone(party), slot-1, slot), nil
+}
+
+// partySwap exchanges the pokemon in two party slots, counted from 1.
+func partySwap(party []int, a int, b int) ([]int, error) {
+	if a < 1 || a > len(party) || b < 1 || b > len(party) {
+		return party, errNoSuchSlot
+	}
+	swapped := slices.Clone(party)
+	swapped[a-1], swapped[b-1] = swapped[b-1], swapped[a-1]
+	return swapped, nil
+}
+
+func commandParty(conf *config, args ...string) error {
+	if len(args) == 0 {
+		args = []string{"show"}
+	}
+
+	var party []int
+	var err error
+	switch args[0] {
+	case "show":
+		return showParty(conf)
+	case "analyze":
+		return analyzePartyTypes(conf)
+	case "add":
+		if len(args) < 2 {
+			fmt.Println("usage: party add <box id>")
+			return nil
+		}
+		uid, ok := boxUid(conf, args[1])
+		if !ok {
+			return nil
+		}
+		party, err = partyAdd(conf.party, uid)
+	case "remove":
+		if len(args) < 2 {
+			fmt.Println("usage: party remove <slot>")
+			return nil
+		}
+		slot, convErr := strconv.Atoi(args[1])
+		if convErr != nil {
+			fmt.Printf("%s is not a party slot, see `party show`\n", args[1])
+			return nil
+		}
+		party, err = partyRemove(conf.party, slot)
+	case "swap":
+		if len(args) < 3 {
+			fmt.Println("usage: party swap <slot> <slot>")
+			return nil
+		}
+		a, errA := strconv.Atoi(args[1])
+		b, errB := strconv.Atoi(args[2])
+		if errA != nil || errB != nil {
+			fmt.Println("party swap takes two slot numbers, see `party show`")
+			return nil
+		}
+		party, err = partySwap(conf.party, a, b)
+	default:
+		fmt.Println("usage: party add|remove|swap|show|analyze")
+		return nil
+	}
+	if err != nil {
+		fmt.Println(err)
+		return nil
+	}
+
+	conf.party = party
+	if err := writeProfile(conf); err != nil {
+		fmt.Println(err)
+	}
+	return showParty(conf)
+}
+
+func showParty(conf *config) error {
+	if len(conf.party) == 0 {
+		fmt.Println("Your party is empty. Add pokemon from your box with `party add <box id>`.")
+		return nil
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "SLOT\tID\tNAME\tNICKNAME\tLEVEL\tTYPES")
+	for i, uid := range conf.party {
+		record, _ := conf.pokedex.Get(uid)
+		level := "?"
+		if record.Level > 0 {
+			level = strconv.Itoa(record.Level)
+		}
+		fmt.Fprintf(w, "%d\t%d\t%s\t%s\t%s\t%s\n",
+			i+1,
+			uid,
+			pokemonDisplayName(conf, record.Name),
+			record.Nickname,
+			level,
+			strings.Join(record.Types, "/"),
+		)
+	}
+	return w.Flush()
+}
+
+// loadTypeChart fetches the damage relations of every type, as they were in
+// the given generation. Types without any, like unknown and shadow, can't
+// be battled with and are left out.
+func loadTypeChart(conf *config, generation int) (typeChart, error) {
+	typeListRes, err := pokeapi.GetResourceList("https://pokeapi.co/api/v2/type/?limit=100", conf.cache)
+	if err != nil {
+		fmt.Println("error in GetResourceList: %w", err)
+		return typeChart{}, fmt.Errorf("error in GetResourceList: %w", err)
+	}
+	types := []pokeapi.TypeInfo{}
+	for _, typeResource := range typeListRes.Results {
+		typeInfoRes, err := pokeapi.GetTypeInfo(typeResource.Url, conf.cache)
+		if err != nil {
+			fmt.Println("error in GetTypeInfo: %w", err)
+			return typeChart{}, fmt.Errorf("error in GetTypeInfo: %w", err)
+		}
+		relations := typeInfoRes.DamageRelations
+		if len(relations.DoubleDamageTo)+len(relations.HalfDamageTo)+len(relations.NoDamageTo) == 0 {
+			continue
+		}
+		types = append(types, typeInfoRes)
+	}
+	return newTypeChart(types, generation), nil
+}
+
+// analyzePartyTypes reports the party's shared weaknesses, immunities and
+// STAB coverage, followed by a matrix of the damage each member takes from
+// every type. With a game selected, the types and type chart of its
+// generation are used.
+func analyzePartyTypes(conf *config) error {
+	if len(conf.party) == 0 {
+		fmt.Println("Your party is empty. Add pokemon from your box with `party add <box id>`.")
+		return nil
+	}
+	generation := 0
+	if conf.generation != "" {
+		url := "https://pokeapi.co/api/v2/generation/" + conf.generation + "/"
+		generationInfoRes, err := pokeapi.GetGenerationInfo(url, conf.cache)
+		if err != nil {
+			fmt.Println("error in GetGenerationInfo: %w", err)
+			return fmt.Errorf("error in GetGenerationInfo: %w", err)
+		}
+		generation = generationInfoRes.Id
+		fmt.Printf("Using the types of %s.\n", conf.generation)
+	}
+	chart, err := loadTypeChart(conf, generation)
+	if err != nil {
+		return err
+	}
+	members := []partyMember{}
+	for _, uid := range conf.party {
+		record, _ := conf.pokedex.Get(uid)
+		url := "https://pokeapi.co/api/v2/pokemon/" + record.Name + "/"
+		pokemonInfoRes, err := pokeapi.GetPokemonInfo(url, conf.cache)
+		if err != nil {
+			fmt.Println("error in GetPokemonInfo: %w", err)
+			return fmt.Errorf("error in GetPokemonInfo: %w", err)
+		}
+		types := typesForGeneration(pokemonInfoRes.Types, pokemonInfoRes.PastTypes, generation)
+		members = append(members, partyMember{name: record.DisplayName(), types: types})
+	}
+	analysis := analyzeParty(chart, members)
+
+	fmt.Println("Shared weaknesses:")
+	shared := analysis.sharedWeaknesses(chart)
+	if len(shared) == 0 {
+		fmt.Println("  none")
+	}
+	for _, typeName := range shared {
+		line := fmt.Sprintf("  - %s: %s", typeName, strings.Join(analysis.weak[typeName], ", "))
+		safe := slices.Concat(analysis.resist[typeName], analysis.immune[typeName])
+		if len(safe) > 0 {
+			line += fmt.Sprintf(" (taken by %s)", strings.Join(safe, ", "))
+		}
+		fmt.Println(line)
+	}
+
+	fmt.Println("Immunities:")
+	hasImmunity := false
+	for _, typeName := range chart.types {
+		if len(analysis.immune[typeName]) > 0 {
+			hasImmunity = true
+			fmt.Printf("  - %s: %s\n", typeName, strings.Join(analysis.immune[typeName], ", "))
+		}
+	}
+	if !hasImmunity {
+		fmt.Println("  none")
+	}
+
+	uncovered := analysis.uncovered(chart)
+	fmt.Printf("STAB coverage: super effective against %d/%d types\n", len(chart.types)-len(uncovered), len(chart.types))
+	if len(uncovered) > 0 {
+		fmt.Printf("Uncovered types: %s\n", strings.Join(uncovered, ", "))
+	}
+
+	fmt.Println()
+	fmt.Println("Damage taken per attacking type, and the best STAB damage dealt to it:")
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	header := "TYPE"
+	for _, member := range members {
+		header += "\t" + member.name
+	}
+	fmt.Fprintln(w, header+"\tSTAB")
+	for _, typeName := range chart.types {
+		line := typeName
+		best := 0.0
+		for _, member := range members {
+			line += "\t" + formatMultiplier(chart.effectiveness(typeName, member.types))
+			best = max(best, bestStab(chart, member, typeName))
+		}
+		fmt.Fprintln(w, line+"\t"+formatMultiplier(best))
+	}
+	return w.Flush()
+}
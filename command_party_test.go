@@ -0,0 +1,49 @@
+package main
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestPartyAdd(t *testing.T) {
+	party, err := partyAdd([]int{1, 2}, 3)
+	if err != nil || !slices.Equal(party, []int{1, 2, 3}) {
+		t.Errorf("expected [1 2 3], got %v, %v", party, err)
+	}
+	if _, err := partyAdd([]int{1, 2}, 2); !errors.Is(err, errAlreadyParty) {
+		t.Errorf("expected errAlreadyParty, got %v", err)
+	}
+	if _, err := partyAdd([]int{1, 2, 3, 4, 5, 6}, 7); !errors.Is(err, errPartyFull) {
+		t.Errorf("expected errPartyFull, got %v", err)
+	}
+}
+
+func TestPartyRemoveAndSwap(t *testing.T) {
+	cases := []struct {
+		name     string
+		apply    func(party []int) ([]int, error)
+		expected []int
+		err      error
+	}{
+		{name: "remove first", apply: func(p []int) ([]int, error) { return partyRemove(p, 1) }, expected: []int{5, 9}},
+		{name: "remove last", apply: func(p []int) ([]int, error) { return partyRemove(p, 3) }, expected: []int{3, 5}},
+		{name: "remove missing slot", apply: func(p []int) ([]int, error) { return partyRemove(p, 4) }, expected: []int{3, 5, 9}, err: errNoSuchSlot},
+		{name: "swap", apply: func(p []int) ([]int, error) { return partySwap(p, 1, 3) }, expected: []int{9, 5, 3}},
+		{name: "swap missing slot", apply: func(p []int) ([]int, error) { return partySwap(p, 0, 2) }, expected: []int{3, 5, 9}, err: errNoSuchSlot},
+	}
+
+	for _, c := range cases {
+		party := []int{3, 5, 9}
+		actual, err := c.apply(party)
+		if !errors.Is(err, c.err) {
+			t.Errorf("%s: expected error %v, got %v", c.name, c.err, err)
+		}
+		if !slices.Equal(actual, c.expected) {
+			t.Errorf("%s: expected %v, got %v", c.name, c.expected, actual)
+		}
+		if !slices.Equal(party, []int{3, 5, 9}) {
+			t.Errorf("%s: the original party was changed to %v", c.name, party)
+		}
+	}
+}
@@ -0,0 +1,233 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/4mewes/pokedex/internal/pokeapi"
+	"github.com/4mewes/pokedex/internal/save"
+)
+
+// statNames are the base stats --min-stat can filter on.
+var statNames = []string{"hp", "attack", "defense", "special-attack", "special-defense", "speed"}
+
+// baseStatTotal sums a pokemon's base stats.
+func baseStatTotal(record save.CaughtPokemon) int {
+	total := 0
+	for _, stat := range record.Stats {
+		total += stat.Base
+	}
+	return total
+}
+
+// parseMinStats reads minimum base stats like "attack=100,speed=90".
+func parseMinStats(value string) (map[string]int, error) {
+	minStats := make(map[string]int)
+	for _, part := range strings.Split(value, ",") {
+		name, minimum, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("%q should look like attack=100", part)
+		}
+		if !slices.Contains(statNames, name) {
+			return nil, fmt.Errorf("unknown stat %q, use one of %s", name, strings.Join(statNames, ", "))
+		}
+		number, err := strconv.Atoi(minimum)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a number", minimum)
+		}
+		minStats[name] = number
+	}
+	return minStats, nil
+}
+
+func meetsMinStats(record save.CaughtPokemon, minStats map[string]int) bool {
+	for name, minimum := range minStats {
+		met := false
+		for _, stat := range record.Stats {
+			if stat.Name == name && stat.Base >= minimum {
+				met = true
+			}
+		}
+		if !met {
+			return false
+		}
+	}
+	return true
+}
+
+// firstCaught keeps one record per pokemon, the one caught first, so the
+// pokedex lists each pokemon once however many are in the box.
+func firstCaught(records []save.CaughtPokemon) []save.CaughtPokemon {
+	first := make(map[string]int)
+	unique := []save.CaughtPokemon{}
+	for _, record := range records {
+		i, ok := first[record.Name]
+		if !ok {
+			first[record.Name] = len(unique)
+			unique = append(unique, record)
+			continue
+		}
+		if record.CaughtAt.Before(unique[i].CaughtAt) {
+			unique[i] = record
+		}
+	}
+	return unique
+}
+
+// commandPokedex lists the caught pokemon, or with `pokedex progress` how
+// many species have been seen and caught.
+func commandPokedex(conf *config, args ...string) error {
+	args, flags := parseFlags(args)
+	if len(args) > 0 && args[0] == "progress" {
+		return commandPokedexProgress(conf, flags)
+	}
+	if len(args) > 0 {
+		fmt.Println("usage: pokedex [progress] [--type <type>] [--sort id|name|bst|weight|caught] [--min-stat attack=100]")
+		return nil
+	}
+
+	records := conf.pokedex.All()
+	if typeName, ok := flags["type"]; ok {
+		records = conf.pokedex.ByType(typeName)
+	}
+	minStats := map[string]int{}
+	if value, ok := flags["min-stat"]; ok {
+		var err error
+		minStats, err = parseMinStats(value)
+		if err != nil {
+			fmt.Printf("--min-stat: %v\n", err)
+			return nil
+		}
+	}
+	rows := []save.CaughtPokemon{}
+	for _, record := range firstCaught(records) {
+		if meetsMinStats(record, minStats) {
+			rows = append(rows, record)
+		}
+	}
+
+	sortBy := flags["sort"]
+	if sortBy == "" {
+		sortBy = "id"
+	}
+	less := map[string]func(a, b save.CaughtPokemon) bool{
+		"id":     func(a, b save.CaughtPokemon) bool { return a.Id < b.Id },
+		"name":   func(a, b save.CaughtPokemon) bool { return a.Name < b.Name },
+		"bst":    func(a, b save.CaughtPokemon) bool { return baseStatTotal(a) > baseStatTotal(b) },
+		"weight": func(a, b save.CaughtPokemon) bool { return a.Weight > b.Weight },
+		"caught": func(a, b save.CaughtPokemon) bool { return a.CaughtAt.Before(b.CaughtAt) },
+	}
+	lessFn, ok := less[sortBy]
+	if !ok {
+		fmt.Println("--sort must be one of id, name, bst, weight, caught")
+		return nil
+	}
+	sort.SliceStable(rows, func(i, j int) bool { return lessFn(rows[i], rows[j]) })
+
+	if len(rows) == 0 {
+		fmt.Println("No caught pokemon match.")
+		return nil
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tNAME\tTYPES\tBST\tWEIGHT\tCAUGHT")
+	for _, record := range rows {
+		caughtAt := "unknown"
+		if !record.CaughtAt.IsZero() {
+			caughtAt = record.CaughtAt.Local().Format("2006-01-02 15:04")
+		}
+		fmt.Fprintf(w, "#%03d\t%s\t%s\t%d\t%d\t%s\n",
+			record.Id,
+			pokemonDisplayName(conf, record.Name),
+			strings.Join(record.Types, "/"),
+			baseStatTotal(record),
+			record.Weight,
+			caughtAt,
+		)
+	}
+	return w.Flush()
+}
+
+// commandPokedexProgress shows how many species have been seen and caught,
+// overall, per generation and per regional pokedex of the selected game or
+// --dex.
+func commandPokedexProgress(conf *config, flags map[string]string) error {
+
+	generationListRes, err := pokeapi.GetResourceList("https://pokeapi.co/api/v2/generation/?limit=100", conf.cache)
+	if err != nil {
+		fmt.Println("error in GetResourceList: %w", err)
+		return fmt.Errorf("error in GetResourceList: %w", err)
+	}
+	generations := []dexProgress{}
+	national := []string{}
+	for _, generation := range generationListRes.Results {
+		generationInfoRes, err := pokeapi.GetGenerationInfo(generation.Url, conf.cache)
+		if err != nil {
+			fmt.Println("error in GetGenerationInfo: %w", err)
+			return fmt.Errorf("error in GetGenerationInfo: %w", err)
+		}
+		species := []string{}
+		for _, s := range generationInfoRes.PokemonSpecies {
+			species = append(species, s.Name)
+		}
+		national = append(national, species...)
+		generations = append(generations, countProgress(generationInfoRes.Name, species, conf.seen, conf.caught))
+	}
+
+	dexNames := []string{}
+	if dexArg, ok := flags["dex"]; ok {
+		dexName, ok := resolveName(conf, "pokedex", dexArg)
+		if !ok {
+			return nil
+		}
+		dexNames = append(dexNames, dexName)
+	} else if conf.versionGroup != "" {
+		url := "https://pokeapi.co/api/v2/version-group/" + conf.versionGroup + "/"
+		versionGroupInfoRes, err := pokeapi.GetVersionGroupInfo(url, conf.cache)
+		if err != nil {
+			fmt.Println("error in GetVersionGroupInfo: %w", err)
+			return fmt.Errorf("error in GetVersionGroupInfo: %w", err)
+		}
+		for _, pokedex := range versionGroupInfoRes.Pokedexes {
+			dexNames = append(dexNames, pokedex.Name)
+		}
+	}
+	regional := []dexProgress{}
+	for _, dexName := range dexNames {
+		url := "https://pokeapi.co/api/v2/pokedex/" + dexName + "/"
+		pokedexInfoRes, err := pokeapi.GetPokedexInfo(url, conf.cache)
+		if err != nil {
+			fmt.Println("error in GetPokedexInfo: %w", err)
+			return fmt.Errorf("error in GetPokedexInfo: %w", err)
+		}
+		species := []string{}
+		for _, entry := range pokedexInfoRes.PokemonEntries {
+			species = append(species, entry.PokemonSpecies.Name)
+		}
+		regional = append(regional, countProgress(pokedexInfoRes.Name, species, conf.seen, conf.caught))
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "POKEDEX\tSEEN\tCAUGHT")
+	printProgress := func(progress dexProgress) {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", progress.name, formatCompletion(progress.seen, progress.total), formatCompletion(progress.caught, progress.total))
+	}
+	printProgress(countProgress("overall", national, conf.seen, conf.caught))
+	for _, progress := range generations {
+		printProgress(progress)
+	}
+	for _, progress := range regional {
+		printProgress(progress)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if len(dexNames) == 0 {
+		fmt.Println("Select a game or pass --dex <pokedex> to see regional pokedexes too.")
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/4mewes/pokedex/internal/save"
+)
+
+func TestParseMinStats(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected map[string]int
+		fails    bool
+	}{
+		{input: "attack=100", expected: map[string]int{"attack": 100}},
+		{input: "attack=100,speed=90", expected: map[string]int{"attack": 100, "speed": 90}},
+		{input: "attack", fails: true},
+		{input: "luck=10", fails: true},
+		{input: "speed=fast", fails: true},
+	}
+
+	for _, c := range cases {
+		actual, err := parseMinStats(c.input)
+		if c.fails {
+			if err == nil {
+				t.Errorf("parseMinStats(%q): expected an error", c.input)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parseMinStats(%q): unexpected error %v", c.input, err)
+			continue
+		}
+		if len(actual) != len(c.expected) {
+			t.Errorf("parseMinStats(%q) = %v, expected %v", c.input, actual, c.expected)
+			continue
+		}
+		for name, minimum := range c.expected {
+			if actual[name] != minimum {
+				t.Errorf("parseMinStats(%q) = %v, expected %v", c.input, actual, c.expected)
+			}
+		}
+	}
+}
+
+func TestPokedexFilters(t *testing.T) {
+	now := time.Now()
+	pidgey := save.CaughtPokemon{Uid: 1, Name: "pidgey", CaughtAt: now, Stats: []save.StatRecord{
+		{Name: "hp", Base: 40},
+		{Name: "attack", Base: 45},
+		{Name: "speed", Base: 56},
+	}}
+	olderPidgey := pidgey
+	olderPidgey.Uid = 2
+	olderPidgey.CaughtAt = now.Add(-time.Hour)
+	machamp := save.CaughtPokemon{Uid: 3, Name: "machamp", CaughtAt: now, Stats: []save.StatRecord{
+		{Name: "hp", Base: 90},
+		{Name: "attack", Base: 130},
+		{Name: "speed", Base: 55},
+	}}
+
+	if total := baseStatTotal(pidgey); total != 141 {
+		t.Errorf("expected a base stat total of 141, got %d", total)
+	}
+
+	unique := firstCaught([]save.CaughtPokemon{pidgey, machamp, olderPidgey})
+	if len(unique) != 2 || unique[0].Uid != 2 || unique[1].Uid != 3 {
+		t.Errorf("expected the first caught pidgey and machamp, got %+v", unique)
+	}
+
+	if !meetsMinStats(machamp, map[string]int{"attack": 100}) {
+		t.Errorf("expected machamp to have at least 100 attack")
+	}
+	if meetsMinStats(pidgey, map[string]int{"attack": 100}) {
+		t.Errorf("expected pidgey to have less than 100 attack")
+	}
+	if meetsMinStats(machamp, map[string]int{"attack": 100, "speed": 90}) {
+		t.Errorf("expected every minimum to be required")
+	}
+}
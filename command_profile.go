@@ -0,0 +1,136 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/4mewes/pokedex/internal/save"
+)
+
+func commandProfile(conf *config, args ...string) error {
+	if conf.dataDir == "" {
+		fmt.Println("profiles are unavailable, no data directory could be found")
+		return nil
+	}
+	if len(args) == 0 {
+		fmt.Println("usage: profile list|new <name>|switch <name>|delete <name>|rename <old> <new>")
+		return nil
+	}
+
+	switch args[0] {
+	case "list":
+		profiles, err := save.ListProfiles(conf.dataDir)
+		if err != nil {
+			fmt.Println(err)
+			return nil
+		}
+		for _, profile := range profiles {
+			marker := " "
+			stats := conf.stats
+			count := conf.pokedex.Len()
+			if profile == conf.profile {
+				marker = "*"
+			} else {
+				summary, err := save.PeekProfile(conf.dataDir, profile)
+				if err != nil {
+					fmt.Printf("  %s (unreadable: %v)\n", profile, err)
+					continue
+				}
+				stats = summary.Stats
+				count = summary.Pokemon
+			}
+			fmt.Printf("%s %s: %d pokemon, %d throws, %d caught, %d escaped\n",
+				marker, profile, count, stats.Throws, stats.Caught, stats.Escaped)
+		}
+	case "new":
+		if len(args) < 2 {
+			fmt.Println("usage: profile new <name>")
+			return nil
+		}
+		if err := save.CreateProfile(conf.dataDir, args[1]); err != nil {
+			fmt.Println(err)
+			return nil
+		}
+		fmt.Printf("Created profile %s, use `profile switch %s` to play it\n", args[1], args[1])
+	case "switch":
+		if len(args) < 2 {
+			fmt.Println("usage: profile switch <name>")
+			return nil
+		}
+		profiles, err := save.ListProfiles(conf.dataDir)
+		if err != nil {
+			fmt.Println(err)
+			return nil
+		}
+		found := false
+		for _, profile := range profiles {
+			found = found || profile == args[1]
+		}
+		if !found {
+			fmt.Printf("no profile named %s, create it with `profile new %s`\n", args[1], args[1])
+			return nil
+		}
+		if err := writeProfile(conf); err != nil {
+			fmt.Println(err)
+			return nil
+		}
+		previous := conf.profile
+		conf.profile = args[1]
+		if err := loadProfile(conf); err != nil {
+			fmt.Println(err)
+			conf.profile = previous
+			if err := loadProfile(conf); err != nil {
+				fmt.Println(err)
+			}
+			return nil
+		}
+		fmt.Printf("Switched to profile %s (%d pokemon caught)\n", conf.profile, conf.pokedex.Len())
+	case "delete":
+		if len(args) < 2 {
+			fmt.Println("usage: profile delete <name>")
+			return nil
+		}
+		if args[1] == conf.profile {
+			fmt.Println("can't delete the active profile, switch to another one first")
+			return nil
+		}
+		if err := save.DeleteProfile(conf.dataDir, args[1]); err != nil {
+			fmt.Println(err)
+			return nil
+		}
+		fmt.Printf("Deleted profile %s\n", args[1])
+	case "rename":
+		if len(args) < 3 {
+			fmt.Println("usage: profile rename <old> <new>")
+			return nil
+		}
+		active := args[1] == conf.profile
+		if active {
+			// make sure the active profile exists on disk and its
+			// pokemon log is closed before moving it
+			if err := writeProfile(conf); err != nil {
+				fmt.Println(err)
+				return nil
+			}
+			conf.pokedex.Close()
+		}
+		err := save.RenameProfile(conf.dataDir, args[1], args[2])
+		if err != nil {
+			fmt.Println(err)
+		}
+		if active {
+			if err == nil {
+				conf.profile = args[2]
+			}
+			if err := loadProfile(conf); err != nil {
+				fmt.Println(err)
+			}
+		}
+		if err != nil {
+			return nil
+		}
+		fmt.Printf("Renamed profile %s to %s\n", args[1], args[2])
+	default:
+		fmt.Println("usage: profile list|new <name>|switch <name>|delete <name>|rename <old> <new>")
+	}
+	return nil
+}
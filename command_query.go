@@ -0,0 +1,120 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/4mewes/pokedex/internal/save"
+)
+
+const queryDateLayout = "2006-01-02"
+
+// queryCaught looks caught pokemon up through the store's indexes. Every
+// given filter narrows the result down further; --min-stat isn't indexed
+// and is applied last.
+func queryCaught(store *save.Store, flags map[string]string) ([]save.CaughtPokemon, error) {
+	var results []save.CaughtPokemon
+	narrow := func(records []save.CaughtPokemon) {
+		if results == nil {
+			results = records
+			return
+		}
+		uids := make(map[int]bool)
+		for _, record := range records {
+			uids[record.Uid] = true
+		}
+		narrowed := []save.CaughtPokemon{}
+		for _, record := range results {
+			if uids[record.Uid] {
+				narrowed = append(narrowed, record)
+			}
+		}
+		results = narrowed
+	}
+
+	for name, value := range flags {
+		switch name {
+		case "type":
+			narrow(store.ByType(value))
+		case "species":
+			narrow(store.BySpecies(value))
+		case "location":
+			narrow(store.ByLocation(value))
+		case "since", "until", "min-stat":
+		default:
+			return nil, fmt.Errorf("unknown filter --%s", name)
+		}
+	}
+
+	_, hasSince := flags["since"]
+	_, hasUntil := flags["until"]
+	if hasSince || hasUntil {
+		var since, until time.Time
+		var err error
+		if hasSince {
+			since, err = time.ParseInLocation(queryDateLayout, flags["since"], time.Local)
+			if err != nil {
+				return nil, errors.New("--since must look like 2026-01-31")
+			}
+		}
+		if hasUntil {
+			until, err = time.ParseInLocation(queryDateLayout, flags["until"], time.Local)
+			if err != nil {
+				return nil, errors.New("--until must look like 2026-01-31")
+			}
+			// include the whole day
+			until = until.AddDate(0, 0, 1)
+		}
+		narrow(store.CaughtBetween(since, until))
+	}
+
+	if value, ok := flags["min-stat"]; ok {
+		minStats, err := parseMinStats(value)
+		if err != nil {
+			return nil, fmt.Errorf("--min-stat: %w", err)
+		}
+		if results == nil {
+			results = store.All()
+		}
+		filtered := []save.CaughtPokemon{}
+		for _, record := range results {
+			if meetsMinStats(record, minStats) {
+				filtered = append(filtered, record)
+			}
+		}
+		results = filtered
+	}
+	return results, nil
+}
+
+func commandQuery(conf *config, args ...string) error {
+	_, flags := parseFlags(args)
+	if len(flags) == 0 {
+		fmt.Println("usage: query [--type <type>] [--species <species>] [--location <area>] [--min-stat attack=100] [--since YYYY-MM-DD] [--until YYYY-MM-DD]")
+		return nil
+	}
+	results, err := queryCaught(conf.pokedex, flags)
+	if err != nil {
+		fmt.Println(err)
+		return nil
+	}
+
+	if len(results) == 0 {
+		fmt.Println("No caught pokemon match.")
+		return nil
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tTYPES\tLOCATION\tCAUGHT")
+	for _, record := range results {
+		caughtAt := "unknown"
+		if !record.CaughtAt.IsZero() {
+			caughtAt = record.CaughtAt.Local().Format("2006-01-02 15:04")
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", pokemonDisplayName(conf, record.Name), strings.Join(record.Types, "/"), resourceDisplayName(conf, "location-area", record.Location), caughtAt)
+	}
+	return w.Flush()
+}
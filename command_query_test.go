@@ -0,0 +1,63 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/4mewes/pokedex/internal/save"
+)
+
+func TestQueryCaught(t *testing.T) {
+	store, _ := save.OpenStore("")
+	stats := func(attack, speed int) []save.StatRecord {
+		return []save.StatRecord{{Name: "attack", Base: attack}, {Name: "speed", Base: speed}}
+	}
+	for _, record := range []save.CaughtPokemon{
+		{Name: "pidgey", Species: "pidgey", Types: []string{"normal", "flying"}, Location: "route-1-area", Stats: stats(45, 56)},
+		{Name: "rattata", Species: "rattata", Types: []string{"normal"}, Location: "route-1-area", Stats: stats(56, 72)},
+		{Name: "zubat", Species: "zubat", Types: []string{"poison", "flying"}, Location: "mt-moon-1f", Stats: stats(45, 55)},
+	} {
+		store.Put(record)
+	}
+
+	cases := []struct {
+		flags    map[string]string
+		expected []string
+		fails    bool
+	}{
+		{flags: map[string]string{"location": "route-1-area"}, expected: []string{"pidgey", "rattata"}},
+		{flags: map[string]string{"location": "route-1-area", "type": "flying"}, expected: []string{"pidgey"}},
+		{flags: map[string]string{"location": "viridian-forest"}, expected: []string{}},
+		{flags: map[string]string{"min-stat": "speed=56"}, expected: []string{"pidgey", "rattata"}},
+		{flags: map[string]string{"min-stat": "attack=50", "type": "normal"}, expected: []string{"rattata"}},
+		{flags: map[string]string{"min-stat": "attack=50", "location": "mt-moon-1f"}, expected: []string{}},
+		{flags: map[string]string{"min-stat": "luck=1"}, fails: true},
+		{flags: map[string]string{"color": "red"}, fails: true},
+	}
+
+	for _, c := range cases {
+		results, err := queryCaught(store, c.flags)
+		if c.fails {
+			if err == nil {
+				t.Errorf("queryCaught(%v): expected an error", c.flags)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("queryCaught(%v): unexpected error %v", c.flags, err)
+			continue
+		}
+		names := []string{}
+		for _, record := range results {
+			names = append(names, record.Name)
+		}
+		if len(names) != len(c.expected) {
+			t.Errorf("queryCaught(%v) = %v, expected %v", c.flags, names, c.expected)
+			continue
+		}
+		for i := range names {
+			if names[i] != c.expected[i] {
+				t.Errorf("queryCaught(%v) = %v, expected %v", c.flags, names, c.expected)
+			}
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/4mewes/pokedex/internal/pokeapi"
+)
+
+func commandRegions(conf *config, args ...string) error {
+	url := "https://pokeapi.co/api/v2/region/?limit=100"
+	regionListRes, err := pokeapi.GetResourceList(url, conf.cache)
+	if err != nil {
+		fmt.Println("error in GetResourceList: %w", err)
+		return fmt.Errorf("error in GetResourceList: %w", err)
+	}
+
+	for _, region := range regionListRes.Results {
+		fmt.Println(resourceDisplayName(conf, "region", region.Name))
+	}
+	return nil
+}
+
+func commandRegion(conf *config, args ...string) error {
+	if len(args) == 0 {
+		fmt.Println("please provide a region name, see `regions`")
+		return nil
+	}
+	name, ok := resolveName(conf, "region", args[0])
+	if !ok {
+		return nil
+	}
+	url := "https://pokeapi.co/api/v2/region/" + name + "/"
+	regionInfoRes, err := pokeapi.GetRegionInfo(url, conf.cache)
+	if err != nil {
+		fmt.Println("error in GetRegionInfo: %w", err)
+		return fmt.Errorf("error in GetRegionInfo: %w", err)
+	}
+
+	fmt.Printf("Region: %s (%s)\n", localizedName(conf, regionInfoRes.Names, regionInfoRes.Name), regionInfoRes.MainGeneration.Name)
+	fmt.Println("Locations:")
+	for _, location := range regionInfoRes.Locations {
+		fmt.Printf("- %s\n", resourceDisplayName(conf, "location", location.Name))
+	}
+	return nil
+}
+
+func commandLocation(conf *config, args ...string) error {
+	if len(args) == 0 {
+		fmt.Println("please provide a location name, see `region <name>`")
+		return nil
+	}
+	name, ok := resolveName(conf, "location", args[0])
+	if !ok {
+		return nil
+	}
+	url := "https://pokeapi.co/api/v2/location/" + name + "/"
+	locationInfoRes, err := pokeapi.GetLocationInfo(url, conf.cache)
+	if err != nil {
+		fmt.Println("error in GetLocationInfo: %w", err)
+		return fmt.Errorf("error in GetLocationInfo: %w", err)
+	}
+
+	fmt.Printf("Location: %s (%s)\n", localizedName(conf, locationInfoRes.Names, locationInfoRes.Name), locationInfoRes.Region.Name)
+	if len(locationInfoRes.Areas) == 0 {
+		fmt.Println("This location has no areas to explore.")
+		return nil
+	}
+	fmt.Println("Areas:")
+	for _, area := range locationInfoRes.Areas {
+		fmt.Printf("- %s\n", resourceDisplayName(conf, "location-area", area.Name))
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/4mewes/pokedex/internal/pokeapi"
+)
+
+// machineItemName turns user input like "24", "tm24" or "hm3" into the
+// item name pokeapi uses for that machine, e.g. "tm24" or "hm03".
+func machineItemName(input string) (string, bool) {
+	prefix := "tm"
+	if strings.HasPrefix(input, "tm") || strings.HasPrefix(input, "hm") {
+		prefix = input[:2]
+		input = input[2:]
+	}
+	number, err := strconv.Atoi(input)
+	if err != nil || number < 0 {
+		return "", false
+	}
+	return fmt.Sprintf("%s%02d", prefix, number), true
+}
+
+// machineForMove returns the machine item (e.g. "tm24") that teaches a move
+// in the given version group, or "" if it is not a machine there.
+func machineForMove(conf *config, moveUrl string, versionGroup string) (string, error) {
+	moveInfoRes, err := pokeapi.GetMoveInfo(moveUrl, conf.cache)
+	if err != nil {
+		return "", fmt.Errorf("error in GetMoveInfo: %w", err)
+	}
+	for _, machine := range moveInfoRes.Machines {
+		if machine.VersionGroup.Name != versionGroup {
+			continue
+		}
+		machineInfoRes, err := pokeapi.GetMachineInfo(machine.Machine.Url, conf.cache)
+		if err != nil {
+			return "", fmt.Errorf("error in GetMachineInfo: %w", err)
+		}
+		return machineInfoRes.Item.Name, nil
+	}
+	return "", nil
+}
+
+func commandTm(conf *config, args ...string) error {
+	args, flags := parseFlags(args)
+	if len(args) == 0 {
+		fmt.Println("please provide a machine number, e.g. `tm 24` or `tm hm03`")
+		return nil
+	}
+	itemName, ok := machineItemName(args[0])
+	if !ok {
+		fmt.Println("machine number must look like 24, tm24 or hm03")
+		return nil
+	}
+	versionGroup := flags["version-group"]
+	if versionGroup == "" {
+		versionGroup = conf.versionGroup
+	}
+	if versionGroup == "" {
+		fmt.Println("please select a game with `game <version>` or pass --version-group <group>")
+		return nil
+	}
+
+	url := "https://pokeapi.co/api/v2/item/" + itemName + "/"
+	itemInfoRes, err := pokeapi.GetItemInfo(url, conf.cache)
+	if err != nil {
+		fmt.Println("error in GetItemInfo: %w", err)
+		return fmt.Errorf("error in GetItemInfo: %w", err)
+	}
+
+	moveName := ""
+	for _, machine := range itemInfoRes.Machines {
+		if machine.VersionGroup.Name != versionGroup {
+			continue
+		}
+		machineInfoRes, err := pokeapi.GetMachineInfo(machine.Machine.Url, conf.cache)
+		if err != nil {
+			fmt.Println("error in GetMachineInfo: %w", err)
+			return fmt.Errorf("error in GetMachineInfo: %w", err)
+		}
+		moveName = machineInfoRes.Move.Name
+	}
+	if moveName == "" {
+		fmt.Printf("%s does not exist in %s\n", itemName, versionGroup)
+		return nil
+	}
+
+	fmt.Printf("%s teaches %s in %s\n", itemName, moveName, versionGroup)
+	learners := []string{}
+	for _, name := range caughtNames(conf) {
+		// saved pokemon don't keep their moves, so look them up again
+		pokemon, err := pokeapi.GetPokemonInfo("https://pokeapi.co/api/v2/pokemon/"+name+"/", conf.cache)
+		if err != nil {
+			fmt.Println("error in GetPokemonInfo: %w", err)
+			return fmt.Errorf("error in GetPokemonInfo: %w", err)
+		}
+		for _, move := range pokemon.Moves {
+			if move.Move.Name != moveName {
+				continue
+			}
+			for _, details := range move.VersionGroupDetails {
+				if details.VersionGroup.Name == versionGroup && details.MoveLearnMethod.Name == "machine" {
+					learners = append(learners, name)
+				}
+			}
+		}
+	}
+	if len(learners) == 0 {
+		fmt.Println("None of your pokemon can learn it.")
+		return nil
+	}
+	fmt.Println("Pokemon in your pokedex that can learn it:")
+	for _, name := range learners {
+		fmt.Printf("- %s\n", name)
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/4mewes/pokedex/internal/pokeapi"
+)
+
+func formatEncounterDetails(details pokeapi.EncounterDetails) string {
+	levels := fmt.Sprintf("lv %d", details.MinLevel)
+	if details.MaxLevel != details.MinLevel {
+		levels = fmt.Sprintf("lv %d-%d", details.MinLevel, details.MaxLevel)
+	}
+	line := fmt.Sprintf("%s, %s, %d%%", details.Method.Name, levels, details.Chance)
+	if len(details.ConditionValues) > 0 {
+		conditions := []string{}
+		for _, condition := range details.ConditionValues {
+			conditions = append(conditions, condition.Name)
+		}
+		line += " (" + strings.Join(conditions, ", ") + ")"
+	}
+	return line
+}
+
+func commandWhereis(conf *config, args ...string) error {
+	args, flags := parseFlags(args)
+	if len(args) == 0 {
+		fmt.Println("please provide a pokemon name")
+		return nil
+	}
+	pokemon, ok := resolvePokemonName(conf, args[0])
+	if !ok {
+		return nil
+	}
+	version := flags["version"]
+	if version == "" {
+		version = conf.game
+	}
+
+	url := "https://pokeapi.co/api/v2/pokemon/" + pokemon + "/"
+	pokemonInfoRes, err := pokeapi.GetPokemonInfo(url, conf.cache)
+	if err != nil {
+		fmt.Println("error in GetPokemonInfo: %w", err)
+		return fmt.Errorf("error in GetPokemonInfo: %w", err)
+	}
+
+	encountersRes, err := pokeapi.GetPokemonEncounters(pokemonInfoRes.LocationAreaEncounters, conf.cache)
+	if err != nil {
+		fmt.Println("error in GetPokemonEncounters: %w", err)
+		return fmt.Errorf("error in GetPokemonEncounters: %w", err)
+	}
+
+	found := false
+	for _, encounter := range encountersRes {
+		printedArea := false
+		for _, versionDetails := range encounter.VersionDetails {
+			if version != "" && versionDetails.Version.Name != version {
+				continue
+			}
+			if !printedArea {
+				fmt.Printf("%s:\n", resourceDisplayName(conf, "location-area", encounter.LocationArea.Name))
+				printedArea = true
+			}
+			fmt.Printf("  %s (up to %d%%):\n", versionDetails.Version.Name, versionDetails.MaxChance)
+			for _, details := range versionDetails.EncounterDetails {
+				fmt.Printf("    - %s\n", formatEncounterDetails(details))
+			}
+		}
+		found = found || printedArea
+	}
+
+	if !found {
+		if version != "" {
+			fmt.Printf("%s can't be found in the wild in %s\n", pokemon, version)
+		} else {
+			fmt.Printf("%s can't be found in the wild\n", pokemon)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,7 @@
+package pokeapi
+
+import "errors"
+
+// ErrNotFound is returned when pokeapi has no resource at the requested url,
+// usually because of a misspelled name.
+var ErrNotFound = errors.New("not found")
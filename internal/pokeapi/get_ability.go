@@ -0,0 +1,39 @@
+package pokeapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/4mewes/pokedex/internal/pokecache"
+)
+
+func GetAbilityInfo(url string, cache *pokecache.Cache) (AbilityInfo, error) {
+	body, ok := cache.Get(url)
+	if !ok {
+		res, err := http.Get(url)
+		if err != nil {
+			fmt.Println("Error requesting: %w", err)
+			return AbilityInfo{}, fmt.Errorf("Error requesting: pokeapi.co/api/v2/ability/: %w", err)
+		}
+		defer res.Body.Close()
+		if res.StatusCode == http.StatusNotFound {
+			return AbilityInfo{}, fmt.Errorf("%s: %w", url, ErrNotFound)
+		}
+		body, err = io.ReadAll(res.Body)
+		if err != nil {
+			fmt.Println("Error reading body: %w", err)
+			return AbilityInfo{}, fmt.Errorf("Error reading body: %w", err)
+		}
+		cache.Add(url, body)
+	}
+
+	var abilityInfoRes AbilityInfo
+	err := json.Unmarshal(body, &abilityInfoRes)
+	if err != nil {
+		fmt.Println("Errr unmarshaling: %w", err)
+		return AbilityInfo{}, fmt.Errorf("Error unmarshalling: %w", err)
+	}
+	return abilityInfoRes, nil
+}
@@ -0,0 +1,68 @@
+package pokeapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/4mewes/pokedex/internal/pokecache"
+)
+
+func GetBerryInfo(url string, cache *pokecache.Cache) (BerryInfo, error) {
+	body, ok := cache.Get(url)
+	if !ok {
+		res, err := http.Get(url)
+		if err != nil {
+			fmt.Println("Error requesting: %w", err)
+			return BerryInfo{}, fmt.Errorf("Error requesting: pokeapi.co/api/v2/berry/: %w", err)
+		}
+		defer res.Body.Close()
+		if res.StatusCode == http.StatusNotFound {
+			return BerryInfo{}, fmt.Errorf("%s: %w", url, ErrNotFound)
+		}
+		body, err = io.ReadAll(res.Body)
+		if err != nil {
+			fmt.Println("Error reading body: %w", err)
+			return BerryInfo{}, fmt.Errorf("Error reading body: %w", err)
+		}
+		cache.Add(url, body)
+	}
+
+	var berryInfoRes BerryInfo
+	err := json.Unmarshal(body, &berryInfoRes)
+	if err != nil {
+		fmt.Println("Errr unmarshaling: %w", err)
+		return BerryInfo{}, fmt.Errorf("Error unmarshalling: %w", err)
+	}
+	return berryInfoRes, nil
+}
+
+func GetBerryFlavorInfo(url string, cache *pokecache.Cache) (BerryFlavorInfo, error) {
+	body, ok := cache.Get(url)
+	if !ok {
+		res, err := http.Get(url)
+		if err != nil {
+			fmt.Println("Error requesting: %w", err)
+			return BerryFlavorInfo{}, fmt.Errorf("Error requesting: pokeapi.co/api/v2/berry-flavor/: %w", err)
+		}
+		defer res.Body.Close()
+		if res.StatusCode == http.StatusNotFound {
+			return BerryFlavorInfo{}, fmt.Errorf("%s: %w", url, ErrNotFound)
+		}
+		body, err = io.ReadAll(res.Body)
+		if err != nil {
+			fmt.Println("Error reading body: %w", err)
+			return BerryFlavorInfo{}, fmt.Errorf("Error reading body: %w", err)
+		}
+		cache.Add(url, body)
+	}
+
+	var berryFlavorInfoRes BerryFlavorInfo
+	err := json.Unmarshal(body, &berryFlavorInfoRes)
+	if err != nil {
+		fmt.Println("Errr unmarshaling: %w", err)
+		return BerryFlavorInfo{}, fmt.Errorf("Error unmarshalling: %w", err)
+	}
+	return berryFlavorInfoRes, nil
+}
@@ -0,0 +1,97 @@
+package pokeapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/4mewes/pokedex/internal/pokecache"
+)
+
+func GetVersionInfo(url string, cache *pokecache.Cache) (VersionInfo, error) {
+	body, ok := cache.Get(url)
+	if !ok {
+		res, err := http.Get(url)
+		if err != nil {
+			fmt.Println("Error requesting: %w", err)
+			return VersionInfo{}, fmt.Errorf("Error requesting: pokeapi.co/api/v2/version/: %w", err)
+		}
+		defer res.Body.Close()
+		if res.StatusCode == http.StatusNotFound {
+			return VersionInfo{}, fmt.Errorf("%s: %w", url, ErrNotFound)
+		}
+		body, err = io.ReadAll(res.Body)
+		if err != nil {
+			fmt.Println("Error reading body: %w", err)
+			return VersionInfo{}, fmt.Errorf("Error reading body: %w", err)
+		}
+		cache.Add(url, body)
+	}
+
+	var versionInfoRes VersionInfo
+	err := json.Unmarshal(body, &versionInfoRes)
+	if err != nil {
+		fmt.Println("Errr unmarshaling: %w", err)
+		return VersionInfo{}, fmt.Errorf("Error unmarshalling: %w", err)
+	}
+	return versionInfoRes, nil
+}
+
+func GetVersionGroupInfo(url string, cache *pokecache.Cache) (VersionGroupInfo, error) {
+	body, ok := cache.Get(url)
+	if !ok {
+		res, err := http.Get(url)
+		if err != nil {
+			fmt.Println("Error requesting: %w", err)
+			return VersionGroupInfo{}, fmt.Errorf("Error requesting: pokeapi.co/api/v2/version-group/: %w", err)
+		}
+		defer res.Body.Close()
+		if res.StatusCode == http.StatusNotFound {
+			return VersionGroupInfo{}, fmt.Errorf("%s: %w", url, ErrNotFound)
+		}
+		body, err = io.ReadAll(res.Body)
+		if err != nil {
+			fmt.Println("Error reading body: %w", err)
+			return VersionGroupInfo{}, fmt.Errorf("Error reading body: %w", err)
+		}
+		cache.Add(url, body)
+	}
+
+	var versionGroupInfoRes VersionGroupInfo
+	err := json.Unmarshal(body, &versionGroupInfoRes)
+	if err != nil {
+		fmt.Println("Errr unmarshaling: %w", err)
+		return VersionGroupInfo{}, fmt.Errorf("Error unmarshalling: %w", err)
+	}
+	return versionGroupInfoRes, nil
+}
+
+func GetGenerationInfo(url string, cache *pokecache.Cache) (GenerationInfo, error) {
+	body, ok := cache.Get(url)
+	if !ok {
+		res, err := http.Get(url)
+		if err != nil {
+			fmt.Println("Error requesting: %w", err)
+			return GenerationInfo{}, fmt.Errorf("Error requesting: pokeapi.co/api/v2/generation/: %w", err)
+		}
+		defer res.Body.Close()
+		if res.StatusCode == http.StatusNotFound {
+			return GenerationInfo{}, fmt.Errorf("%s: %w", url, ErrNotFound)
+		}
+		body, err = io.ReadAll(res.Body)
+		if err != nil {
+			fmt.Println("Error reading body: %w", err)
+			return GenerationInfo{}, fmt.Errorf("Error reading body: %w", err)
+		}
+		cache.Add(url, body)
+	}
+
+	var generationInfoRes GenerationInfo
+	err := json.Unmarshal(body, &generationInfoRes)
+	if err != nil {
+		fmt.Println("Errr unmarshaling: %w", err)
+		return GenerationInfo{}, fmt.Errorf("Error unmarshalling: %w", err)
+	}
+	return generationInfoRes, nil
+}
@@ -0,0 +1,39 @@
+package pokeapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/4mewes/pokedex/internal/pokecache"
+)
+
+func GetItemInfo(url string, cache *pokecache.Cache) (ItemInfo, error) {
+	body, ok := cache.Get(url)
+	if !ok {
+		res, err := http.Get(url)
+		if err != nil {
+			fmt.Println("Error requesting: %w", err)
+			return ItemInfo{}, fmt.Errorf("Error requesting: pokeapi.co/api/v2/item/: %w", err)
+		}
+		defer res.Body.Close()
+		if res.StatusCode == http.StatusNotFound {
+			return ItemInfo{}, fmt.Errorf("%s: %w", url, ErrNotFound)
+		}
+		body, err = io.ReadAll(res.Body)
+		if err != nil {
+			fmt.Println("Error reading body: %w", err)
+			return ItemInfo{}, fmt.Errorf("Error reading body: %w", err)
+		}
+		cache.Add(url, body)
+	}
+
+	var itemInfoRes ItemInfo
+	err := json.Unmarshal(body, &itemInfoRes)
+	if err != nil {
+		fmt.Println("Errr unmarshaling: %w", err)
+		return ItemInfo{}, fmt.Errorf("Error unmarshalling: %w", err)
+	}
+	return itemInfoRes, nil
+}
@@ -0,0 +1,39 @@
+package pokeapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/4mewes/pokedex/internal/pokecache"
+)
+
+func GetResourceList(url string, cache *pokecache.Cache) (ResourceList, error) {
+	body, ok := cache.Get(url)
+	if !ok {
+		res, err := http.Get(url)
+		if err != nil {
+			fmt.Println("Error requesting: %w", err)
+			return ResourceList{}, fmt.Errorf("Error requesting: %s: %w", url, err)
+		}
+		defer res.Body.Close()
+		if res.StatusCode == http.StatusNotFound {
+			return ResourceList{}, fmt.Errorf("%s: %w", url, ErrNotFound)
+		}
+		body, err = io.ReadAll(res.Body)
+		if err != nil {
+			fmt.Println("Error reading body: %w", err)
+			return ResourceList{}, fmt.Errorf("Error reading body: %w", err)
+		}
+		cache.Add(url, body)
+	}
+
+	var resourceListRes ResourceList
+	err := json.Unmarshal(body, &resourceListRes)
+	if err != nil {
+		fmt.Println("Errr unmarshaling: %w", err)
+		return ResourceList{}, fmt.Errorf("Error unmarshalling: %w", err)
+	}
+	return resourceListRes, nil
+}
@@ -19,6 +19,9 @@ func GetLocationArea(url string, cache *pokecache.Cache) (LocationArea, error) {
 			return LocationArea{}, fmt.Errorf("Error requesting: pokeapi.co/api/v2/location-area/: %w", err)
 		}
 		defer res.Body.Close()
+		if res.StatusCode == http.StatusNotFound {
+			return LocationArea{}, fmt.Errorf("%s: %w", url, ErrNotFound)
+		}
 		body, err = io.ReadAll(res.Body)
 		if err != nil {
 			fmt.Println("Error reading body: %w", err)
@@ -46,6 +49,9 @@ func GetLocationAreaInfo(url string, cache *pokecache.Cache) (LocationAreaInfo,
 			return LocationAreaInfo{}, fmt.Errorf("Error requesting: pokeapi.co/api/v2/location-area/: %w", err)
 		}
 		defer res.Body.Close()
+		if res.StatusCode == http.StatusNotFound {
+			return LocationAreaInfo{}, fmt.Errorf("%s: %w", url, ErrNotFound)
+		}
 		body, err = io.ReadAll(res.Body)
 		if err != nil {
 			fmt.Println("Error reading body: %w", err)
@@ -62,3 +68,33 @@ func GetLocationAreaInfo(url string, cache *pokecache.Cache) (LocationAreaInfo,
 	}
 	return locationAreaInfoRes, nil
 }
+
+func GetLocationInfo(url string, cache *pokecache.Cache) (LocationInfo, error) {
+	//check cache
+	body, ok := cache.Get(url)
+	if !ok {
+		res, err := http.Get(url)
+		if err != nil {
+			fmt.Println("Error requesting: %w", err)
+			return LocationInfo{}, fmt.Errorf("Error requesting: pokeapi.co/api/v2/location/: %w", err)
+		}
+		defer res.Body.Close()
+		if res.StatusCode == http.StatusNotFound {
+			return LocationInfo{}, fmt.Errorf("%s: %w", url, ErrNotFound)
+		}
+		body, err = io.ReadAll(res.Body)
+		if err != nil {
+			fmt.Println("Error reading body: %w", err)
+			return LocationInfo{}, fmt.Errorf("Error reading body: %w", err)
+		}
+		cache.Add(url, body)
+	}
+
+	var locationInfoRes LocationInfo
+	err := json.Unmarshal(body, &locationInfoRes)
+	if err != nil {
+		fmt.Println("Errr unmarshaling: %w", err)
+		return LocationInfo{}, fmt.Errorf("Error unmarshalling: %w", err)
+	}
+	return locationInfoRes, nil
+}
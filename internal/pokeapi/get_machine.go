@@ -0,0 +1,39 @@
+package pokeapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/4mewes/pokedex/internal/pokecache"
+)
+
+func GetMachineInfo(url string, cache *pokecache.Cache) (MachineInfo, error) {
+	body, ok := cache.Get(url)
+	if !ok {
+		res, err := http.Get(url)
+		if err != nil {
+			fmt.Println("Error requesting: %w", err)
+			return MachineInfo{}, fmt.Errorf("Error requesting: pokeapi.co/api/v2/machine/: %w", err)
+		}
+		defer res.Body.Close()
+		if res.StatusCode == http.StatusNotFound {
+			return MachineInfo{}, fmt.Errorf("%s: %w", url, ErrNotFound)
+		}
+		body, err = io.ReadAll(res.Body)
+		if err != nil {
+			fmt.Println("Error reading body: %w", err)
+			return MachineInfo{}, fmt.Errorf("Error reading body: %w", err)
+		}
+		cache.Add(url, body)
+	}
+
+	var machineInfoRes MachineInfo
+	err := json.Unmarshal(body, &machineInfoRes)
+	if err != nil {
+		fmt.Println("Errr unmarshaling: %w", err)
+		return MachineInfo{}, fmt.Errorf("Error unmarshalling: %w", err)
+	}
+	return machineInfoRes, nil
+}
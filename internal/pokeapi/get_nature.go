@@ -0,0 +1,39 @@
+package pokeapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/4mewes/pokedex/internal/pokecache"
+)
+
+func GetNatureInfo(url string, cache *pokecache.Cache) (NatureInfo, error) {
+	body, ok := cache.Get(url)
+	if !ok {
+		res, err := http.Get(url)
+		if err != nil {
+			fmt.Println("Error requesting: %w", err)
+			return NatureInfo{}, fmt.Errorf("Error requesting: pokeapi.co/api/v2/nature/: %w", err)
+		}
+		defer res.Body.Close()
+		if res.StatusCode == http.StatusNotFound {
+			return NatureInfo{}, fmt.Errorf("%s: %w", url, ErrNotFound)
+		}
+		body, err = io.ReadAll(res.Body)
+		if err != nil {
+			fmt.Println("Error reading body: %w", err)
+			return NatureInfo{}, fmt.Errorf("Error reading body: %w", err)
+		}
+		cache.Add(url, body)
+	}
+
+	var natureInfoRes NatureInfo
+	err := json.Unmarshal(body, &natureInfoRes)
+	if err != nil {
+		fmt.Println("Errr unmarshaling: %w", err)
+		return NatureInfo{}, fmt.Errorf("Error unmarshalling: %w", err)
+	}
+	return natureInfoRes, nil
+}
@@ -0,0 +1,39 @@
+package pokeapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/4mewes/pokedex/internal/pokecache"
+)
+
+func GetPokedexInfo(url string, cache *pokecache.Cache) (PokedexInfo, error) {
+	body, ok := cache.Get(url)
+	if !ok {
+		res, err := http.Get(url)
+		if err != nil {
+			fmt.Println("Error requesting: %w", err)
+			return PokedexInfo{}, fmt.Errorf("Error requesting: pokeapi.co/api/v2/pokedex/: %w", err)
+		}
+		defer res.Body.Close()
+		if res.StatusCode == http.StatusNotFound {
+			return PokedexInfo{}, fmt.Errorf("%s: %w", url, ErrNotFound)
+		}
+		body, err = io.ReadAll(res.Body)
+		if err != nil {
+			fmt.Println("Error reading body: %w", err)
+			return PokedexInfo{}, fmt.Errorf("Error reading body: %w", err)
+		}
+		cache.Add(url, body)
+	}
+
+	var pokedexInfoRes PokedexInfo
+	err := json.Unmarshal(body, &pokedexInfoRes)
+	if err != nil {
+		fmt.Println("Errr unmarshaling: %w", err)
+		return PokedexInfo{}, fmt.Errorf("Error unmarshalling: %w", err)
+	}
+	return pokedexInfoRes, nil
+}
@@ -18,6 +18,9 @@ func GetPokemonInfo(url string, cache *pokecache.Cache) (PokemonInfo, error) {
 			return PokemonInfo{}, fmt.Errorf("Error requesting: pokeapi.co/api/v2/pokemon/: %w", err)
 		}
 		defer res.Body.Close()
+		if res.StatusCode == http.StatusNotFound {
+			return PokemonInfo{}, fmt.Errorf("%s: %w", url, ErrNotFound)
+		}
 		body, err = io.ReadAll(res.Body)
 		if err != nil {
 			fmt.Println("Error reading body: %w", err)
@@ -34,3 +37,90 @@ func GetPokemonInfo(url string, cache *pokecache.Cache) (PokemonInfo, error) {
 	}
 	return pokemonInfoRes, nil
 }
+
+func GetPokemonEncounters(url string, cache *pokecache.Cache) ([]LocationAreaEncounters, error) {
+	body, ok := cache.Get(url)
+	if !ok {
+		res, err := http.Get(url)
+		if err != nil {
+			fmt.Println("Error requesting: %w", err)
+			return nil, fmt.Errorf("Error requesting: pokeapi.co/api/v2/pokemon/{id}/encounters: %w", err)
+		}
+		defer res.Body.Close()
+		if res.StatusCode == http.StatusNotFound {
+			return nil, fmt.Errorf("%s: %w", url, ErrNotFound)
+		}
+		body, err = io.ReadAll(res.Body)
+		if err != nil {
+			fmt.Println("Error reading body: %w", err)
+			return nil, fmt.Errorf("Error reading body: %w", err)
+		}
+		cache.Add(url, body)
+	}
+
+	var encountersRes []LocationAreaEncounters
+	err := json.Unmarshal(body, &encountersRes)
+	if err != nil {
+		fmt.Println("Errr unmarshaling: %w", err)
+		return nil, fmt.Errorf("Error unmarshalling: %w", err)
+	}
+	return encountersRes, nil
+}
+
+func GetPokemonSpeciesInfo(url string, cache *pokecache.Cache) (PokemonSpeciesInfo, error) {
+	body, ok := cache.Get(url)
+	if !ok {
+		res, err := http.Get(url)
+		if err != nil {
+			fmt.Println("Error requesting: %w", err)
+			return PokemonSpeciesInfo{}, fmt.Errorf("Error requesting: pokeapi.co/api/v2/pokemon-species/: %w", err)
+		}
+		defer res.Body.Close()
+		if res.StatusCode == http.StatusNotFound {
+			return PokemonSpeciesInfo{}, fmt.Errorf("%s: %w", url, ErrNotFound)
+		}
+		body, err = io.ReadAll(res.Body)
+		if err != nil {
+			fmt.Println("Error reading body: %w", err)
+			return PokemonSpeciesInfo{}, fmt.Errorf("Error reading body: %w", err)
+		}
+		cache.Add(url, body)
+	}
+
+	var pokemonSpeciesInfoRes PokemonSpeciesInfo
+	err := json.Unmarshal(body, &pokemonSpeciesInfoRes)
+	if err != nil {
+		fmt.Println("Errr unmarshaling: %w", err)
+		return PokemonSpeciesInfo{}, fmt.Errorf("Error unmarshalling: %w", err)
+	}
+	return pokemonSpeciesInfoRes, nil
+}
+
+func GetPokemonFormInfo(url string, cache *pokecache.Cache) (PokemonFormInfo, error) {
+	body, ok := cache.Get(url)
+	if !ok {
+		res, err := http.Get(url)
+		if err != nil {
+			fmt.Println("Error requesting: %w", err)
+			return PokemonFormInfo{}, fmt.Errorf("Error requesting: pokeapi.co/api/v2/pokemon-form/: %w", err)
+		}
+		defer res.Body.Close()
+		if res.StatusCode == http.StatusNotFound {
+			return PokemonFormInfo{}, fmt.Errorf("%s: %w", url, ErrNotFound)
+		}
+		body, err = io.ReadAll(res.Body)
+		if err != nil {
+			fmt.Println("Error reading body: %w", err)
+			return PokemonFormInfo{}, fmt.Errorf("Error reading body: %w", err)
+		}
+		cache.Add(url, body)
+	}
+
+	var pokemonFormInfoRes PokemonFormInfo
+	err := json.Unmarshal(body, &pokemonFormInfoRes)
+	if err != nil {
+		fmt.Println("Errr unmarshaling: %w", err)
+		return PokemonFormInfo{}, fmt.Errorf("Error unmarshalling: %w", err)
+	}
+	return pokemonFormInfoRes, nil
+}
@@ -0,0 +1,39 @@
+package pokeapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/4mewes/pokedex/internal/pokecache"
+)
+
+func GetRegionInfo(url string, cache *pokecache.Cache) (RegionInfo, error) {
+	body, ok := cache.Get(url)
+	if !ok {
+		res, err := http.Get(url)
+		if err != nil {
+			fmt.Println("Error requesting: %w", err)
+			return RegionInfo{}, fmt.Errorf("Error requesting: pokeapi.co/api/v2/region/: %w", err)
+		}
+		defer res.Body.Close()
+		if res.StatusCode == http.StatusNotFound {
+			return RegionInfo{}, fmt.Errorf("%s: %w", url, ErrNotFound)
+		}
+		body, err = io.ReadAll(res.Body)
+		if err != nil {
+			fmt.Println("Error reading body: %w", err)
+			return RegionInfo{}, fmt.Errorf("Error reading body: %w", err)
+		}
+		cache.Add(url, body)
+	}
+
+	var regionInfoRes RegionInfo
+	err := json.Unmarshal(body, &regionInfoRes)
+	if err != nil {
+		fmt.Println("Errr unmarshaling: %w", err)
+		return RegionInfo{}, fmt.Errorf("Error unmarshalling: %w", err)
+	}
+	return regionInfoRes, nil
+}
@@ -0,0 +1,30 @@
+package pokeapi
+
+type AbilityInfo struct {
+	EffectEntries     []EffectEntries     `json:"effect_entries,omitempty"`
+	FlavorTextEntries []FlavorTextEntries `json:"flavor_text_entries,omitempty"`
+	Generation        Generation          `json:"generation,omitempty"`
+	Id                int                 `json:"id,omitempty"`
+	IsMainSeries      bool                `json:"is_main_series,omitempty"`
+	Name              string              `json:"name,omitempty"`
+	Names             []Names             `json:"names,omitempty"`
+	Pokemon           []AbilityPokemon    `json:"pokemon,omitempty"`
+}
+
+type EffectEntries struct {
+	Effect      string   `json:"effect,omitempty"`
+	Language    Language `json:"language,omitempty"`
+	ShortEffect string   `json:"short_effect,omitempty"`
+}
+
+type FlavorTextEntries struct {
+	FlavorText   string       `json:"flavor_text,omitempty"`
+	Language     Language     `json:"language,omitempty"`
+	VersionGroup VersionGroup `json:"version_group,omitempty"`
+}
+
+type AbilityPokemon struct {
+	IsHidden bool    `json:"is_hidden,omitempty"`
+	Pokemon  Pokemon `json:"pokemon,omitempty"`
+	Slot     int     `json:"slot,omitempty"`
+}
@@ -0,0 +1,48 @@
+package pokeapi
+
+type BerryInfo struct {
+	Firmness         BerryFirmness  `json:"firmness,omitempty"`
+	Flavors          []BerryFlavors `json:"flavors,omitempty"`
+	GrowthTime       int            `json:"growth_time,omitempty"`
+	Id               int            `json:"id,omitempty"`
+	Item             Item           `json:"item,omitempty"`
+	MaxHarvest       int            `json:"max_harvest,omitempty"`
+	Name             string         `json:"name,omitempty"`
+	NaturalGiftPower int            `json:"natural_gift_power,omitempty"`
+	NaturalGiftType  Type           `json:"natural_gift_type,omitempty"`
+	Size             int            `json:"size,omitempty"`
+	Smoothness       int            `json:"smoothness,omitempty"`
+	SoilDryness      int            `json:"soil_dryness,omitempty"`
+}
+
+type BerryFirmness struct {
+	Name string `json:"name,omitempty"`
+	Url  string `json:"url,omitempty"`
+}
+
+type BerryFlavor struct {
+	Name string `json:"name,omitempty"`
+	Url  string `json:"url,omitempty"`
+}
+
+type BerryFlavors struct {
+	Flavor  BerryFlavor `json:"flavor,omitempty"`
+	Potency int         `json:"potency,omitempty"`
+}
+
+type Berry struct {
+	Name string `json:"name,omitempty"`
+	Url  string `json:"url,omitempty"`
+}
+
+type FlavorBerries struct {
+	Berry   Berry `json:"berry,omitempty"`
+	Potency int   `json:"potency,omitempty"`
+}
+
+type BerryFlavorInfo struct {
+	Berries []FlavorBerries `json:"berries,omitempty"`
+	Id      int             `json:"id,omitempty"`
+	Name    string          `json:"name,omitempty"`
+	Names   []Names         `json:"names,omitempty"`
+}
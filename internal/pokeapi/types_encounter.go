@@ -0,0 +1,30 @@
+package pokeapi
+
+type Area struct {
+	Name string `json:"name,omitempty"`
+	Url  string `json:"url,omitempty"`
+}
+
+type LocationAreaEncounters struct {
+	LocationArea   Area                      `json:"location_area,omitempty"`
+	VersionDetails []EncounterVersionDetails `json:"version_details,omitempty"`
+}
+
+type EncounterVersionDetails struct {
+	EncounterDetails []EncounterDetails `json:"encounter_details,omitempty"`
+	MaxChance        int                `json:"max_chance,omitempty"`
+	Version          Version            `json:"version,omitempty"`
+}
+
+type ConditionValues struct {
+	Name string `json:"name,omitempty"`
+	Url  string `json:"url,omitempty"`
+}
+
+type EncounterDetails struct {
+	Chance          int               `json:"chance,omitempty"`
+	ConditionValues []ConditionValues `json:"condition_values,omitempty"`
+	MaxLevel        int               `json:"max_level,omitempty"`
+	Method          EncounterMethod   `json:"method,omitempty"`
+	MinLevel        int               `json:"min_level,omitempty"`
+}
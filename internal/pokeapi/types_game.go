@@ -0,0 +1,31 @@
+package pokeapi
+
+type VersionInfo struct {
+	Id           int          `json:"id,omitempty"`
+	Name         string       `json:"name,omitempty"`
+	Names        []Names      `json:"names,omitempty"`
+	VersionGroup VersionGroup `json:"version_group,omitempty"`
+}
+
+type VersionGroupInfo struct {
+	Generation       Generation        `json:"generation,omitempty"`
+	Id               int               `json:"id,omitempty"`
+	MoveLearnMethods []MoveLearnMethod `json:"move_learn_methods,omitempty"`
+	Name             string            `json:"name,omitempty"`
+	Order            int               `json:"order,omitempty"`
+	Pokedexes        []Pokedex         `json:"pokedexes,omitempty"`
+	Regions          []Region          `json:"regions,omitempty"`
+	Versions         []Version         `json:"versions,omitempty"`
+}
+
+type GenerationInfo struct {
+	Abilities      []Ability      `json:"abilities,omitempty"`
+	Id             int            `json:"id,omitempty"`
+	MainRegion     Region         `json:"main_region,omitempty"`
+	Moves          []Move         `json:"moves,omitempty"`
+	Name           string         `json:"name,omitempty"`
+	Names          []Names        `json:"names,omitempty"`
+	PokemonSpecies []Species      `json:"pokemon_species,omitempty"`
+	Types          []Type         `json:"types,omitempty"`
+	VersionGroups  []VersionGroup `json:"version_groups,omitempty"`
+}
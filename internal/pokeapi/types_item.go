@@ -0,0 +1,47 @@
+package pokeapi
+
+type ItemInfo struct {
+	Attributes        []ItemAttribute         `json:"attributes,omitempty"`
+	Category          ItemCategory            `json:"category,omitempty"`
+	Cost              int                     `json:"cost,omitempty"`
+	EffectEntries     []EffectEntries         `json:"effect_entries,omitempty"`
+	FlavorTextEntries []ItemFlavorTextEntries `json:"flavor_text_entries,omitempty"`
+	FlingEffect       ItemFlingEffect         `json:"fling_effect,omitempty"`
+	FlingPower        int                     `json:"fling_power,omitempty"`
+	HeldByPokemon     []HeldByPokemon         `json:"held_by_pokemon,omitempty"`
+	Id                int                     `json:"id,omitempty"`
+	Machines          []MachineVersionDetails `json:"machines,omitempty"`
+	Name              string                  `json:"name,omitempty"`
+	Names             []Names                 `json:"names,omitempty"`
+	Sprites           ItemSprites             `json:"sprites,omitempty"`
+}
+
+type ItemAttribute struct {
+	Name string `json:"name,omitempty"`
+	Url  string `json:"url,omitempty"`
+}
+
+type ItemCategory struct {
+	Name string `json:"name,omitempty"`
+	Url  string `json:"url,omitempty"`
+}
+
+type ItemFlingEffect struct {
+	Name string `json:"name,omitempty"`
+	Url  string `json:"url,omitempty"`
+}
+
+type ItemFlavorTextEntries struct {
+	Language     Language     `json:"language,omitempty"`
+	Text         string       `json:"text,omitempty"`
+	VersionGroup VersionGroup `json:"version_group,omitempty"`
+}
+
+type HeldByPokemon struct {
+	Pokemon        Pokemon                  `json:"pokemon,omitempty"`
+	VersionDetails []HeldItemVersionDetails `json:"version_details,omitempty"`
+}
+
+type ItemSprites struct {
+	Default string `json:"default,omitempty"`
+}
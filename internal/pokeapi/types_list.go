@@ -0,0 +1,13 @@
+package pokeapi
+
+type ResourceList struct {
+	Count    int        `json:"count"`
+	Next     string     `json:"next"`
+	Previous string     `json:"previous"`
+	Results  []Resource `json:"results"`
+}
+
+type Resource struct {
+	Name string `json:"name"`
+	Url  string `json:"url"`
+}
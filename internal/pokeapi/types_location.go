@@ -61,6 +61,6 @@ type Pokemon struct {
 }
 
 type PokemonEncounters struct {
-	Pokemon        Pokemon          `json:"pokemon,omitempty"`
-	VersionDetails []VersionDetails `json:"version_details,omitempty"`
+	Pokemon        Pokemon                   `json:"pokemon,omitempty"`
+	VersionDetails []EncounterVersionDetails `json:"version_details,omitempty"`
 }
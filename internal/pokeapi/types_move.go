@@ -0,0 +1,36 @@
+package pokeapi
+
+type MoveInfo struct {
+	Accuracy          int                     `json:"accuracy,omitempty"`
+	DamageClass       MoveDamageClass         `json:"damage_class,omitempty"`
+	EffectEntries     []EffectEntries         `json:"effect_entries,omitempty"`
+	FlavorTextEntries []FlavorTextEntries     `json:"flavor_text_entries,omitempty"`
+	Id                int                     `json:"id,omitempty"`
+	Machines          []MachineVersionDetails `json:"machines,omitempty"`
+	Name              string                  `json:"name,omitempty"`
+	Names             []Names                 `json:"names,omitempty"`
+	Power             int                     `json:"power,omitempty"`
+	Pp                int                     `json:"pp,omitempty"`
+	Type              Type                    `json:"type,omitempty"`
+}
+
+type MoveDamageClass struct {
+	Name string `json:"name,omitempty"`
+	Url  string `json:"url,omitempty"`
+}
+
+type Machine struct {
+	Url string `json:"url,omitempty"`
+}
+
+type MachineVersionDetails struct {
+	Machine      Machine      `json:"machine,omitempty"`
+	VersionGroup VersionGroup `json:"version_group,omitempty"`
+}
+
+type MachineInfo struct {
+	Id           int          `json:"id,omitempty"`
+	Item         Item         `json:"item,omitempty"`
+	Move         Move         `json:"move,omitempty"`
+	VersionGroup VersionGroup `json:"version_group,omitempty"`
+}
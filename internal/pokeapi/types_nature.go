@@ -0,0 +1,11 @@
+package pokeapi
+
+type NatureInfo struct {
+	DecreasedStat Stat        `json:"decreased_stat,omitempty"`
+	HatesFlavor   BerryFlavor `json:"hates_flavor,omitempty"`
+	Id            int         `json:"id,omitempty"`
+	IncreasedStat Stat        `json:"increased_stat,omitempty"`
+	LikesFlavor   BerryFlavor `json:"likes_flavor,omitempty"`
+	Name          string      `json:"name,omitempty"`
+	Names         []Names     `json:"names,omitempty"`
+}
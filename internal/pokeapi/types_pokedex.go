@@ -0,0 +1,22 @@
+package pokeapi
+
+type PokedexInfo struct {
+	Descriptions   []Descriptions   `json:"descriptions,omitempty"`
+	Id             int              `json:"id,omitempty"`
+	IsMainSeries   bool             `json:"is_main_series,omitempty"`
+	Name           string           `json:"name,omitempty"`
+	Names          []Names          `json:"names,omitempty"`
+	PokemonEntries []PokemonEntries `json:"pokemon_entries,omitempty"`
+	Region         Region           `json:"region,omitempty"`
+	VersionGroups  []VersionGroup   `json:"version_groups,omitempty"`
+}
+
+type Descriptions struct {
+	Description string   `json:"description,omitempty"`
+	Language    Language `json:"language,omitempty"`
+}
+
+type PokemonEntries struct {
+	EntryNumber    int     `json:"entry_number,omitempty"`
+	PokemonSpecies Species `json:"pokemon_species,omitempty"`
+}
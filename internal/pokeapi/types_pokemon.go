@@ -15,7 +15,7 @@ type PokemonInfo struct {
 	Name                   string          `json:"name,omitempty"`
 	Order                  int             `json:"order,omitempty"`
 	PastAbilities          []PastAbilities `json:"past_abilities,omitempty"`
-	PastTypes              []interface{}   `json:"past_types,omitempty"`
+	PastTypes              []PastTypes     `json:"past_types,omitempty"`
 	Species                Species         `json:"species,omitempty"`
 	Sprites                Sprites         `json:"sprites,omitempty"`
 	Stats                  []Stats         `json:"stats,omitempty"`
@@ -54,9 +54,14 @@ type Item struct {
 	Url  string `json:"url,omitempty"`
 }
 
+type HeldItemVersionDetails struct {
+	Rarity  int     `json:"rarity,omitempty"`
+	Version Version `json:"version,omitempty"`
+}
+
 type HeldItems struct {
-	Item           Item             `json:"item,omitempty"`
-	VersionDetails []VersionDetails `json:"version_details,omitempty"`
+	Item           Item                     `json:"item,omitempty"`
+	VersionDetails []HeldItemVersionDetails `json:"version_details,omitempty"`
 }
 
 type Move struct {
@@ -96,6 +101,11 @@ type PastAbilities struct {
 	Generation Generation  `json:"generation,omitempty"`
 }
 
+type PastTypes struct {
+	Generation Generation `json:"generation,omitempty"`
+	Types      []Types    `json:"types,omitempty"`
+}
+
 type Species struct {
 	Name string `json:"name,omitempty"`
 	Url  string `json:"url,omitempty"`
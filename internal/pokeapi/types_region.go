@@ -0,0 +1,29 @@
+package pokeapi
+
+type Region struct {
+	Name string `json:"name,omitempty"`
+	Url  string `json:"url,omitempty"`
+}
+
+type Pokedex struct {
+	Name string `json:"name,omitempty"`
+	Url  string `json:"url,omitempty"`
+}
+
+type RegionInfo struct {
+	Id             int            `json:"id,omitempty"`
+	Locations      []Location     `json:"locations,omitempty"`
+	MainGeneration Generation     `json:"main_generation,omitempty"`
+	Name           string         `json:"name,omitempty"`
+	Names          []Names        `json:"names,omitempty"`
+	Pokedexes      []Pokedex      `json:"pokedexes,omitempty"`
+	VersionGroups  []VersionGroup `json:"version_groups,omitempty"`
+}
+
+type LocationInfo struct {
+	Areas  []Area  `json:"areas,omitempty"`
+	Id     int     `json:"id,omitempty"`
+	Name   string  `json:"name,omitempty"`
+	Names  []Names `json:"names,omitempty"`
+	Region Region  `json:"region,omitempty"`
+}
@@ -0,0 +1,52 @@
+package pokeapi
+
+type PokemonSpeciesInfo struct {
+	BaseHappiness     int                        `json:"base_happiness,omitempty"`
+	CaptureRate       int                        `json:"capture_rate,omitempty"`
+	FlavorTextEntries []SpeciesFlavorTextEntries `json:"flavor_text_entries,omitempty"`
+	Genera            []Genera                   `json:"genera,omitempty"`
+	Generation        Generation                 `json:"generation,omitempty"`
+	Id                int                        `json:"id,omitempty"`
+	IsLegendary       bool                       `json:"is_legendary,omitempty"`
+	IsMythical        bool                       `json:"is_mythical,omitempty"`
+	Name              string                     `json:"name,omitempty"`
+	Names             []Names                    `json:"names,omitempty"`
+	PokedexNumbers    []PokedexNumbers           `json:"pokedex_numbers,omitempty"`
+	Varieties         []Varieties                `json:"varieties,omitempty"`
+}
+
+type SpeciesFlavorTextEntries struct {
+	FlavorText string   `json:"flavor_text,omitempty"`
+	Language   Language `json:"language,omitempty"`
+	Version    Version  `json:"version,omitempty"`
+}
+
+type Genera struct {
+	Genus    string   `json:"genus,omitempty"`
+	Language Language `json:"language,omitempty"`
+}
+
+type PokedexNumbers struct {
+	EntryNumber int     `json:"entry_number,omitempty"`
+	Pokedex     Pokedex `json:"pokedex,omitempty"`
+}
+
+type Varieties struct {
+	IsDefault bool    `json:"is_default,omitempty"`
+	Pokemon   Pokemon `json:"pokemon,omitempty"`
+}
+
+type PokemonFormInfo struct {
+	FormName     string       `json:"form_name,omitempty"`
+	FormNames    []Names      `json:"form_names,omitempty"`
+	Id           int          `json:"id,omitempty"`
+	IsBattleOnly bool         `json:"is_battle_only,omitempty"`
+	IsDefault    bool         `json:"is_default,omitempty"`
+	IsMega       bool         `json:"is_mega,omitempty"`
+	Name         string       `json:"name,omitempty"`
+	Names        []Names      `json:"names,omitempty"`
+	Order        int          `json:"order,omitempty"`
+	Pokemon      Pokemon      `json:"pokemon,omitempty"`
+	Types        []Types      `json:"types,omitempty"`
+	VersionGroup VersionGroup `json:"version_group,omitempty"`
+}
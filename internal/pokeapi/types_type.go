@@ -0,0 +1,24 @@
+package pokeapi
+
+type TypeInfo struct {
+	DamageRelations     DamageRelations       `json:"damage_relations,omitempty"`
+	Generation          Generation            `json:"generation,omitempty"`
+	Id                  int                   `json:"id,omitempty"`
+	Name                string                `json:"name,omitempty"`
+	Names               []Names               `json:"names,omitempty"`
+	PastDamageRelations []PastDamageRelations `json:"past_damage_relations,omitempty"`
+}
+
+type PastDamageRelations struct {
+	DamageRelations DamageRelations `json:"damage_relations,omitempty"`
+	Generation      Generation      `json:"generation,omitempty"`
+}
+
+type DamageRelations struct {
+	DoubleDamageFrom []Type `json:"double_damage_from,omitempty"`
+	DoubleDamageTo   []Type `json:"double_damage_to,omitempty"`
+	HalfDamageFrom   []Type `json:"half_damage_from,omitempty"`
+	HalfDamageTo     []Type `json:"half_damage_to,omitempty"`
+	NoDamageFrom     []Type `json:"no_damage_from,omitempty"`
+	NoDamageTo       []Type `json:"no_damage_to,omitempty"`
+}
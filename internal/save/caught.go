@@ -0,0 +1,130 @@
+package save
+
+import (
+	"time"
+
+	"github.com/4mewes/pokedex/internal/pokeapi"
+)
+
+// CaughtPokemon is the compact form of a caught pokemon: how and where it
+// was caught, plus only the api data the pokedex commands display.
+// Everything else can be fetched from pokeapi.
+type CaughtPokemon struct {
+	Uid            int             `json:"uid"`
+	CaughtAt       time.Time       `json:"caught_at"`
+	Location       string          `json:"location,omitempty"`
+	Throws         int             `json:"throws,omitempty"`
+	Level          int             `json:"level,omitempty"`
+	Nickname       string          `json:"nickname,omitempty"`
+	Id             int             `json:"id"`
+	Name           string          `json:"name"`
+	Species        string          `json:"species,omitempty"`
+	IsDefault      bool            `json:"is_default,omitempty"`
+	BaseExperience int             `json:"base_experience,omitempty"`
+	Height         int             `json:"height"`
+	Weight         int             `json:"weight"`
+	Types          []string        `json:"types"`
+	Stats          []StatRecord    `json:"stats"`
+	Abilities      []AbilityRecord `json:"abilities,omitempty"`
+}
+
+type StatRecord struct {
+	Name   string `json:"name"`
+	Base   int    `json:"base"`
+	Effort int    `json:"effort,omitempty"`
+}
+
+type AbilityRecord struct {
+	Name   string `json:"name"`
+	Hidden bool   `json:"hidden,omitempty"`
+	Slot   int    `json:"slot"`
+}
+
+// SpeciesName falls back to the pokemon name for records saved before the
+// species was tracked.
+func (r CaughtPokemon) SpeciesName() string {
+	if r.Species != "" {
+		return r.Species
+	}
+	return r.Name
+}
+
+func NewCaughtPokemon(pokemon pokeapi.PokemonInfo) CaughtPokemon {
+	record := CaughtPokemon{
+		Id:             pokemon.Id,
+		Name:           pokemon.Name,
+		Species:        pokemon.Species.Name,
+		IsDefault:      pokemon.IsDefault,
+		BaseExperience: pokemon.BaseExperience,
+		Height:         pokemon.Height,
+		Weight:         pokemon.Weight,
+		Types:          []string{},
+		Stats:          []StatRecord{},
+	}
+	for _, pokemonType := range pokemon.Types {
+		record.Types = append(record.Types, pokemonType.Type.Name)
+	}
+	for _, stat := range pokemon.Stats {
+		record.Stats = append(record.Stats, StatRecord{
+			Name:   stat.Stat.Name,
+			Base:   stat.BaseStat,
+			Effort: stat.Effort,
+		})
+	}
+	for _, ability := range pokemon.Abilities {
+		record.Abilities = append(record.Abilities, AbilityRecord{
+			Name:   ability.Ability.Name,
+			Hidden: ability.IsHidden,
+			Slot:   ability.Slot,
+		})
+	}
+	return record
+}
+
+// DisplayName is the nickname if the pokemon has one.
+func (r CaughtPokemon) DisplayName() string {
+	if r.Nickname != "" {
+		return r.Nickname
+	}
+	return r.Name
+}
+
+// PokemonInfo expands the record back into the api type the commands work
+// with. Fields that are not saved are left empty.
+func (r CaughtPokemon) PokemonInfo() pokeapi.PokemonInfo {
+	pokemon := pokeapi.PokemonInfo{
+		Id:             r.Id,
+		Name:           r.Name,
+		IsDefault:      r.IsDefault,
+		BaseExperience: r.BaseExperience,
+		Height:         r.Height,
+		Weight:         r.Weight,
+	}
+	if r.Species != "" {
+		pokemon.Species = pokeapi.Species{
+			Name: r.Species,
+			Url:  "https://pokeapi.co/api/v2/pokemon-species/" + r.Species + "/",
+		}
+	}
+	for i, typeName := range r.Types {
+		pokemon.Types = append(pokemon.Types, pokeapi.Types{
+			Slot: i + 1,
+			Type: pokeapi.Type{Name: typeName, Url: "https://pokeapi.co/api/v2/type/" + typeName + "/"},
+		})
+	}
+	for _, stat := range r.Stats {
+		pokemon.Stats = append(pokemon.Stats, pokeapi.Stats{
+			BaseStat: stat.Base,
+			Effort:   stat.Effort,
+			Stat:     pokeapi.Stat{Name: stat.Name},
+		})
+	}
+	for _, ability := range r.Abilities {
+		pokemon.Abilities = append(pokemon.Abilities, pokeapi.Abilities{
+			Ability:  pokeapi.Ability{Name: ability.Name},
+			IsHidden: ability.Hidden,
+			Slot:     ability.Slot,
+		})
+	}
+	return pokemon
+}
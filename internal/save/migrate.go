@@ -0,0 +1,164 @@
+package save
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// Migration upgrades a decoded save from version From to From+1. Saves are
+// migrated as plain json documents so old layouts don't need Go types. dir
+// is the profile directory, for migrations that move data out of save.json.
+type Migration struct {
+	From        int
+	Description string
+	Up          func(dir string, doc map[string]any) error
+}
+
+// migrations must cover every version from 1 up to SchemaVersion-1, in
+// order. Add one whenever SchemaVersion is bumped, together with a
+// testdata/v<N>.json fixture of the old format.
+var migrations = []Migration{
+	{
+		From:        1,
+		Description: "add settings and stats",
+		Up: func(dir string, doc map[string]any) error {
+			if _, ok := doc["settings"]; !ok {
+				doc["settings"] = map[string]any{}
+			}
+			if _, ok := doc["stats"]; !ok {
+				doc["stats"] = map[string]any{"throws": 0, "caught": 0, "escaped": 0}
+			}
+			return nil
+		},
+	},
+	{
+		From:        2,
+		Description: "move caught pokemon into the pokemon log",
+		Up: func(dir string, doc map[string]any) error {
+			data, err := json.Marshal(doc["pokemon"])
+			if err != nil {
+				return err
+			}
+			records := []CaughtPokemon{}
+			if err := json.Unmarshal(data, &records); err != nil {
+				return err
+			}
+
+			// a previous attempt may have died before save.json was
+			// rewritten, start the log over so nothing is imported twice
+			path := filepath.Join(dir, StoreFile)
+			if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+				return err
+			}
+			store, err := OpenStore(path)
+			if err != nil {
+				return err
+			}
+			defer store.Close()
+			for _, record := range records {
+				if _, err := store.Put(record); err != nil {
+					return err
+				}
+			}
+			delete(doc, "pokemon")
+			return nil
+		},
+	},
+	{
+		From:        3,
+		Description: "track caught species apart from the box",
+		Up: func(dir string, doc map[string]any) error {
+			store, err := OpenStore(filepath.Join(dir, StoreFile))
+			if err != nil {
+				return err
+			}
+			defer store.Close()
+			caught := []string{}
+			seen := make(map[string]bool)
+			for _, record := range store.All() {
+				species := record.SpeciesName()
+				if !seen[species] {
+					seen[species] = true
+					caught = append(caught, species)
+				}
+			}
+			sort.Strings(caught)
+			doc["caught"] = caught
+			return nil
+		},
+	},
+	{
+		From:        4,
+		Description: "track seen species, everything caught was seen",
+		Up: func(dir string, doc map[string]any) error {
+			// caught is still a []string when the previous migration
+			// ran in the same load
+			switch caught := doc["caught"].(type) {
+			case []string:
+				doc["seen"] = append([]string{}, caught...)
+			case []any:
+				doc["seen"] = append([]any{}, caught...)
+			default:
+				doc["seen"] = []string{}
+			}
+			return nil
+		},
+	},
+	{
+		From:        5,
+		Description: "add an empty party",
+		Up: func(dir string, doc map[string]any) error {
+			doc["party"] = []int{}
+			return nil
+		},
+	},
+}
+
+// saveVersion reads the version of an encoded save. Saves written before
+// the field existed are version 1.
+func saveVersion(data []byte) (int, error) {
+	var header struct {
+		Version int `json:"version"`
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return 0, fmt.Errorf("error unmarshalling save file: %w", err)
+	}
+	if header.Version == 0 {
+		return 1, nil
+	}
+	return header.Version, nil
+}
+
+// migrate runs every migration needed to bring data up to SchemaVersion.
+func migrate(dir string, data []byte, version int) ([]byte, error) {
+	doc := map[string]any{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("error unmarshalling save file: %w", err)
+	}
+	for _, migration := range migrations {
+		if migration.From < version {
+			continue
+		}
+		if err := migration.Up(dir, doc); err != nil {
+			return nil, fmt.Errorf("error migrating save from version %d (%s): %w", migration.From, migration.Description, err)
+		}
+		doc["version"] = migration.From + 1
+	}
+	return json.Marshal(doc)
+}
+
+// backupPath is where the untouched save is kept before migrating it.
+func backupPath(path string, version int) string {
+	return fmt.Sprintf("%s.v%d.bak", path, version)
+}
+
+func backup(path string, data []byte, version int) error {
+	if err := os.WriteFile(backupPath(path, version), data, 0o600); err != nil {
+		return fmt.Errorf("error backing up save file: %w", err)
+	}
+	return nil
+}
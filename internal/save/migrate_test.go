@@ -0,0 +1,88 @@
+package save
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestMigrationsCoverEveryVersion(t *testing.T) {
+	if len(migrations) != SchemaVersion-1 {
+		t.Errorf("expected %d migrations, got %d", SchemaVersion-1, len(migrations))
+	}
+	for i, migration := range migrations {
+		if migration.From != i+1 {
+			t.Errorf("migration %d upgrades from version %d, expected %d", i, migration.From, i+1)
+		}
+	}
+}
+
+func TestLoadFixtures(t *testing.T) {
+	for version := 1; version <= SchemaVersion; version++ {
+		t.Run(fmt.Sprintf("version %d", version), func(t *testing.T) {
+			data, err := os.ReadFile(filepath.Join("testdata", fmt.Sprintf("v%d.json", version)))
+			if err != nil {
+				t.Errorf("missing fixture for version %d: %v", version, err)
+				return
+			}
+			dir := t.TempDir()
+			path := filepath.Join(dir, "save.json")
+			os.WriteFile(path, data, 0o600)
+			// from version 3 on the pokemon live in their own log
+			logData, err := os.ReadFile(filepath.Join("testdata", fmt.Sprintf("v%d.%s", version, StoreFile)))
+			if err == nil {
+				os.WriteFile(filepath.Join(dir, StoreFile), logData, 0o600)
+			}
+
+			file, err := Load(path)
+			if err != nil {
+				t.Errorf("expected no error loading, got %v", err)
+				return
+			}
+			if file.Version != SchemaVersion {
+				t.Errorf("expected version %d, got %d", SchemaVersion, file.Version)
+			}
+			store, err := OpenStore(filepath.Join(dir, StoreFile))
+			if err != nil {
+				t.Errorf("expected no error opening the pokemon log, got %v", err)
+				return
+			}
+			defer store.Close()
+			if records := store.All(); len(records) != 1 || records[0].Name != "pikachu" {
+				t.Errorf("expected pikachu to survive the migration, got %+v", records)
+			}
+
+			_, err = os.Stat(backupPath(path, version))
+			if version < SchemaVersion && err != nil {
+				t.Errorf("expected a backup of the version %d save: %v", version, err)
+			}
+			if version == SchemaVersion && err == nil {
+				t.Errorf("expected no backup for a current save")
+			}
+
+			reloaded, err := Load(path)
+			if err != nil || reloaded.Version != SchemaVersion {
+				t.Errorf("expected the migrated save to be written back, got %+v, %v", reloaded, err)
+			}
+		})
+	}
+}
+
+func TestMigrateCaughtAndSeenSpecies(t *testing.T) {
+	data, _ := os.ReadFile(filepath.Join("testdata", "v1.json"))
+	path := filepath.Join(t.TempDir(), "save.json")
+	os.WriteFile(path, data, 0o600)
+
+	file, err := Load(path)
+	if err != nil {
+		t.Errorf("expected no error loading, got %v", err)
+		return
+	}
+	if len(file.Caught) != 1 || file.Caught[0] != "pikachu" {
+		t.Errorf("expected pikachu to be caught, got %v", file.Caught)
+	}
+	if len(file.Seen) != 1 || file.Seen[0] != "pikachu" {
+		t.Errorf("expected pikachu to be seen, got %v", file.Seen)
+	}
+}
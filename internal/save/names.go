@@ -0,0 +1,53 @@
+package save
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+)
+
+var langPattern = regexp.MustCompile(`^[a-z][a-z-]*$`)
+
+// NameIndex maps localized names back to api slugs per endpoint, e.g.
+// index["pokemon-species"]["glurak"] is "charizard". It is shared by every
+// profile, since it only holds api data.
+type NameIndex map[string]map[string]string
+
+// NameIndexPath returns where the name index of a language is kept.
+func NameIndexPath(dataDir string, lang string) string {
+	return filepath.Join(dataDir, "names", lang+".json")
+}
+
+// LoadNameIndex reads the name index of a language. A language without one
+// gives an empty index.
+func LoadNameIndex(dataDir string, lang string) (NameIndex, error) {
+	if !langPattern.MatchString(lang) {
+		return nil, fmt.Errorf("invalid language %q", lang)
+	}
+	data, err := os.ReadFile(NameIndexPath(dataDir, lang))
+	if errors.Is(err, os.ErrNotExist) {
+		return NameIndex{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading name index: %w", err)
+	}
+	index := NameIndex{}
+	if err := json.Unmarshal(data, &index); err != nil {
+		return nil, fmt.Errorf("error parsing name index: %w", err)
+	}
+	return index, nil
+}
+
+func WriteNameIndex(dataDir string, lang string, index NameIndex) error {
+	if !langPattern.MatchString(lang) {
+		return fmt.Errorf("invalid language %q", lang)
+	}
+	data, err := json.Marshal(index)
+	if err != nil {
+		return fmt.Errorf("error marshalling name index: %w", err)
+	}
+	return writeAtomic(NameIndexPath(dataDir, lang), data)
+}
@@ -0,0 +1,166 @@
+package save
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+)
+
+const DefaultProfile = "default"
+
+var profileNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// ErrProfileExists and ErrProfileNotFound let callers tell the user what
+// went wrong with a profile command.
+var (
+	ErrProfileExists   = errors.New("profile already exists")
+	ErrProfileNotFound = errors.New("profile not found")
+)
+
+func ValidateProfileName(name string) error {
+	if !profileNamePattern.MatchString(name) {
+		return fmt.Errorf("invalid profile name %q, use lowercase letters, digits, - and _", name)
+	}
+	return nil
+}
+
+func profileDir(dataDir string, name string) string {
+	return filepath.Join(dataDir, "profiles", name)
+}
+
+// ProfilePath returns the save file of a profile.
+func ProfilePath(dataDir string, name string) string {
+	return filepath.Join(profileDir(dataDir, name), "save.json")
+}
+
+func profileExists(dataDir string, name string) bool {
+	_, err := os.Stat(profileDir(dataDir, name))
+	return err == nil
+}
+
+func ListProfiles(dataDir string) ([]string, error) {
+	entries, err := os.ReadDir(filepath.Join(dataDir, "profiles"))
+	if errors.Is(err, os.ErrNotExist) {
+		return []string{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error listing profiles: %w", err)
+	}
+	profiles := []string{}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			profiles = append(profiles, entry.Name())
+		}
+	}
+	sort.Strings(profiles)
+	return profiles, nil
+}
+
+// ProfileSummary is what listing profiles shows of each one.
+type ProfileSummary struct {
+	Version int
+	Stats   Stats
+	Pokemon int
+}
+
+// PeekProfile reads a profile's summary without migrating, writing or
+// compacting anything, so listing profiles never touches other trainers'
+// saves.
+func PeekProfile(dataDir string, name string) (ProfileSummary, error) {
+	if err := ValidateProfileName(name); err != nil {
+		return ProfileSummary{}, err
+	}
+	summary := ProfileSummary{Version: SchemaVersion}
+	data, err := os.ReadFile(ProfilePath(dataDir, name))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return ProfileSummary{}, fmt.Errorf("error reading save file: %w", err)
+	}
+	if err == nil {
+		summary.Version, err = saveVersion(data)
+		if err != nil {
+			return ProfileSummary{}, err
+		}
+		var file struct {
+			Stats   Stats             `json:"stats"`
+			Pokemon []json.RawMessage `json:"pokemon"`
+		}
+		if err := json.Unmarshal(data, &file); err != nil {
+			return ProfileSummary{}, fmt.Errorf("error unmarshalling save file: %w", err)
+		}
+		summary.Stats = file.Stats
+		summary.Pokemon = len(file.Pokemon)
+	}
+	// saves before version 3 kept their pokemon in save.json
+	if summary.Version >= 3 {
+		summary.Pokemon, err = countLog(StorePath(dataDir, name))
+		if err != nil {
+			return ProfileSummary{}, err
+		}
+	}
+	return summary, nil
+}
+
+func CreateProfile(dataDir string, name string) error {
+	if err := ValidateProfileName(name); err != nil {
+		return err
+	}
+	if profileExists(dataDir, name) {
+		return fmt.Errorf("%s: %w", name, ErrProfileExists)
+	}
+	return Write(ProfilePath(dataDir, name), File{})
+}
+
+func DeleteProfile(dataDir string, name string) error {
+	if err := ValidateProfileName(name); err != nil {
+		return err
+	}
+	if !profileExists(dataDir, name) {
+		return fmt.Errorf("%s: %w", name, ErrProfileNotFound)
+	}
+	if err := os.RemoveAll(profileDir(dataDir, name)); err != nil {
+		return fmt.Errorf("error deleting profile: %w", err)
+	}
+	return nil
+}
+
+func RenameProfile(dataDir string, from string, to string) error {
+	if err := ValidateProfileName(from); err != nil {
+		return err
+	}
+	if err := ValidateProfileName(to); err != nil {
+		return err
+	}
+	if !profileExists(dataDir, from) {
+		return fmt.Errorf("%s: %w", from, ErrProfileNotFound)
+	}
+	if profileExists(dataDir, to) {
+		return fmt.Errorf("%s: %w", to, ErrProfileExists)
+	}
+	if err := os.Rename(profileDir(dataDir, from), profileDir(dataDir, to)); err != nil {
+		return fmt.Errorf("error renaming profile: %w", err)
+	}
+	return nil
+}
+
+// MoveLegacySave moves a save from before profiles existed into the
+// default profile, unless that profile already has a save of its own.
+func MoveLegacySave(dataDir string) error {
+	legacyPath := filepath.Join(dataDir, "save.json")
+	if _, err := os.Stat(legacyPath); errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if profileExists(dataDir, DefaultProfile) {
+		return nil
+	}
+	if err := os.MkdirAll(profileDir(dataDir, DefaultProfile), 0o755); err != nil {
+		return fmt.Errorf("error creating default profile: %w", err)
+	}
+	if err := os.Rename(legacyPath, ProfilePath(dataDir, DefaultProfile)); err != nil {
+		return fmt.Errorf("error moving save into default profile: %w", err)
+	}
+	return nil
+}
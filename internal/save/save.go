@@ -0,0 +1,138 @@
+package save
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// SchemaVersion is the version of the save format this build writes.
+const SchemaVersion = 6
+
+// File is a profile's save.json. The individual pokemon in the box live in
+// the profile's pokemon log, see Store. Caught lists every species ever
+// caught, so releasing a pokemon doesn't take it out of the pokedex, and
+// Seen every species ever encountered. Party holds the box uids of the
+// pokemon in the party, in order.
+type File struct {
+	Version  int      `json:"version"`
+	Settings Settings `json:"settings"`
+	Stats    Stats    `json:"stats"`
+	Caught   []string `json:"caught"`
+	Seen     []string `json:"seen"`
+	Party    []int    `json:"party"`
+}
+
+// Settings are the session settings a trainer picked, restored when their
+// profile is loaded.
+type Settings struct {
+	Lang         string `json:"lang,omitempty"`
+	Game         string `json:"game,omitempty"`
+	VersionGroup string `json:"version_group,omitempty"`
+	Generation   string `json:"generation,omitempty"`
+}
+
+type Stats struct {
+	Throws  int `json:"throws"`
+	Caught  int `json:"caught"`
+	Escaped int `json:"escaped"`
+}
+
+// DataDir returns the directory saves live in under $XDG_DATA_HOME,
+// falling back to ~/.local/share like the XDG spec asks.
+func DataDir() (string, error) {
+	dataHome := os.Getenv("XDG_DATA_HOME")
+	if dataHome == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("error finding home directory: %w", err)
+		}
+		dataHome = filepath.Join(home, ".local", "share")
+	}
+	return filepath.Join(dataHome, "pokedex"), nil
+}
+
+// Load reads a save file. A missing file is not an error, it is a new
+// trainer with an empty pokedex. Saves from older versions are backed up,
+// migrated and written back in the current format.
+func Load(path string) (File, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return File{Version: SchemaVersion}, nil
+	}
+	if err != nil {
+		return File{}, fmt.Errorf("error reading save file: %w", err)
+	}
+
+	version, err := saveVersion(data)
+	if err != nil {
+		return File{}, err
+	}
+	if version > SchemaVersion {
+		return File{}, fmt.Errorf("save file version %d is newer than supported version %d", version, SchemaVersion)
+	}
+	migrated := version < SchemaVersion
+	if migrated {
+		if err := backup(path, data, version); err != nil {
+			return File{}, err
+		}
+		data, err = migrate(filepath.Dir(path), data, version)
+		if err != nil {
+			return File{}, err
+		}
+	}
+
+	var file File
+	if err := json.Unmarshal(data, &file); err != nil {
+		return File{}, fmt.Errorf("error unmarshalling save file: %w", err)
+	}
+	if migrated {
+		if err := Write(path, file); err != nil {
+			return File{}, err
+		}
+	}
+	return file, nil
+}
+
+// Write saves the file atomically, so a crash never leaves a half written
+// save behind.
+func Write(path string, file File) error {
+	file.Version = SchemaVersion
+	data, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshalling save file: %w", err)
+	}
+	return writeAtomic(path, data)
+}
+
+// writeAtomic writes data to a temporary file in the same directory and
+// renames it over path.
+func writeAtomic(path string, data []byte) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("error creating save directory: %w", err)
+	}
+	tmp, err := os.CreateTemp(dir, ".save-*.tmp")
+	if err != nil {
+		return fmt.Errorf("error creating temporary save file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("error writing save file: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("error syncing save file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("error closing save file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("error replacing save file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,231 @@
+package save
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/4mewes/pokedex/internal/pokeapi"
+)
+
+func TestLoadMissingFile(t *testing.T) {
+	file, err := Load(filepath.Join(t.TempDir(), "save.json"))
+	if err != nil {
+		t.Errorf("expected no error, got %v", err)
+		return
+	}
+	if file.Version != SchemaVersion {
+		t.Errorf("expected an empty save, got %+v", file)
+	}
+}
+
+func TestWriteLoad(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "pokedex", "save.json")
+	err := Write(path, File{
+		Settings: Settings{Lang: "de", Game: "red"},
+		Stats:    Stats{Throws: 3, Caught: 1, Escaped: 2},
+		Party:    []int{4, 2},
+	})
+	if err != nil {
+		t.Errorf("expected no error writing, got %v", err)
+		return
+	}
+	entries, _ := os.ReadDir(filepath.Dir(path))
+	if len(entries) != 1 {
+		t.Errorf("expected only the save file to be left behind, found %d files", len(entries))
+	}
+
+	file, err := Load(path)
+	if err != nil {
+		t.Errorf("expected no error loading, got %v", err)
+		return
+	}
+	if file.Settings.Lang != "de" || file.Settings.Game != "red" || file.Stats.Throws != 3 {
+		t.Errorf("save does not match: %+v", file)
+	}
+	if len(file.Party) != 2 || file.Party[0] != 4 || file.Party[1] != 2 {
+		t.Errorf("expected the party to keep its order, got %v", file.Party)
+	}
+}
+
+func TestCaughtPokemon(t *testing.T) {
+	pikachu := pokeapi.PokemonInfo{
+		Id:        25,
+		Name:      "pikachu",
+		IsDefault: true,
+		Height:    4,
+		Weight:    60,
+		Species:   pokeapi.Species{Name: "pikachu"},
+		Types:     []pokeapi.Types{{Slot: 1, Type: pokeapi.Type{Name: "electric"}}},
+		Stats: []pokeapi.Stats{
+			{BaseStat: 35, Stat: pokeapi.Stat{Name: "hp"}},
+			{BaseStat: 55, Stat: pokeapi.Stat{Name: "attack"}},
+		},
+		Abilities: []pokeapi.Abilities{
+			{Ability: pokeapi.Ability{Name: "static"}, Slot: 1},
+			{Ability: pokeapi.Ability{Name: "lightning-rod"}, IsHidden: true, Slot: 3},
+		},
+	}
+
+	loaded := NewCaughtPokemon(pikachu).PokemonInfo()
+	if loaded.Name != "pikachu" || loaded.Id != 25 || loaded.Species.Name != "pikachu" {
+		t.Errorf("pokemon does not match: %+v", loaded)
+	}
+	if len(loaded.Types) != 1 || loaded.Types[0].Type.Name != "electric" {
+		t.Errorf("types do not match: %+v", loaded.Types)
+	}
+	if len(loaded.Stats) != 2 || loaded.Stats[1].BaseStat != 55 {
+		t.Errorf("stats do not match: %+v", loaded.Stats)
+	}
+	if len(loaded.Abilities) != 2 || !loaded.Abilities[1].IsHidden {
+		t.Errorf("abilities do not match: %+v", loaded.Abilities)
+	}
+}
+
+func TestLoadNewerVersion(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "save.json")
+	os.WriteFile(path, []byte(`{"version": 999, "pokemon": []}`), 0o644)
+	_, err := Load(path)
+	if err == nil {
+		t.Errorf("expected an error for a save from a newer version")
+	}
+}
+
+func TestProfiles(t *testing.T) {
+	dataDir := t.TempDir()
+	os.WriteFile(filepath.Join(dataDir, "save.json"), []byte(`{"version": 1, "pokemon": [{"id": 25, "name": "pikachu"}]}`), 0o644)
+
+	if err := MoveLegacySave(dataDir); err != nil {
+		t.Errorf("expected no error moving legacy save, got %v", err)
+		return
+	}
+	_, err := Load(ProfilePath(dataDir, DefaultProfile))
+	if err != nil {
+		t.Errorf("expected the legacy save in the default profile, got %v", err)
+	}
+	store, err := OpenStore(StorePath(dataDir, DefaultProfile))
+	if err != nil || store.Len() != 1 {
+		t.Errorf("expected the legacy pokemon in the default profile, got %v", err)
+	}
+	store.Close()
+
+	if err := CreateProfile(dataDir, "misty"); err != nil {
+		t.Errorf("expected no error creating profile, got %v", err)
+	}
+	if err := CreateProfile(dataDir, "misty"); !errors.Is(err, ErrProfileExists) {
+		t.Errorf("expected ErrProfileExists, got %v", err)
+	}
+	if err := CreateProfile(dataDir, "../brock"); err == nil {
+		t.Errorf("expected an error for an invalid profile name")
+	}
+	if err := RenameProfile(dataDir, "misty", "brock"); err != nil {
+		t.Errorf("expected no error renaming profile, got %v", err)
+	}
+	if err := DeleteProfile(dataDir, "misty"); !errors.Is(err, ErrProfileNotFound) {
+		t.Errorf("expected ErrProfileNotFound, got %v", err)
+	}
+
+	profiles, err := ListProfiles(dataDir)
+	if err != nil {
+		t.Errorf("expected no error listing profiles, got %v", err)
+		return
+	}
+	if len(profiles) != 2 || profiles[0] != "brock" || profiles[1] != DefaultProfile {
+		t.Errorf("expected [brock default], got %v", profiles)
+	}
+}
+
+func TestProfileNamesCannotEscape(t *testing.T) {
+	dataDir := filepath.Join(t.TempDir(), "pokedex")
+	if err := CreateProfile(dataDir, "misty"); err != nil {
+		t.Errorf("expected no error creating profile, got %v", err)
+		return
+	}
+	outside := filepath.Join(filepath.Dir(dataDir), "outside.json")
+	os.WriteFile(outside, []byte("{}"), 0o644)
+
+	for _, name := range []string{"..", ".", "../..", "profiles/misty", "misty/..", "/tmp"} {
+		if err := DeleteProfile(dataDir, name); err == nil {
+			t.Errorf("expected DeleteProfile(%q) to be rejected", name)
+		}
+		if err := RenameProfile(dataDir, name, "brock"); err == nil {
+			t.Errorf("expected RenameProfile(%q, brock) to be rejected", name)
+		}
+	}
+
+	if _, err := os.Stat(ProfilePath(dataDir, "misty")); err != nil {
+		t.Errorf("expected the misty profile to survive, got %v", err)
+	}
+	if _, err := os.Stat(outside); err != nil {
+		t.Errorf("expected files outside the data dir to survive, got %v", err)
+	}
+	if profiles, _ := ListProfiles(dataDir); len(profiles) != 1 || profiles[0] != "misty" {
+		t.Errorf("expected only misty, got %v", profiles)
+	}
+}
+
+func TestNameIndex(t *testing.T) {
+	dataDir := t.TempDir()
+	index, err := LoadNameIndex(dataDir, "de")
+	if err != nil || len(index) != 0 {
+		t.Errorf("expected an empty index before one is written, got %v, %v", index, err)
+	}
+
+	index = NameIndex{"pokemon-species": {"glurak": "charizard"}}
+	if err := WriteNameIndex(dataDir, "de", index); err != nil {
+		t.Errorf("expected no error writing the index, got %v", err)
+	}
+	loaded, err := LoadNameIndex(dataDir, "de")
+	if err != nil || loaded["pokemon-species"]["glurak"] != "charizard" {
+		t.Errorf("expected glurak to resolve to charizard, got %v, %v", loaded, err)
+	}
+
+	if _, err := LoadNameIndex(dataDir, "../de"); err == nil {
+		t.Errorf("expected an invalid language to be rejected")
+	}
+}
+
+func TestPeekProfile(t *testing.T) {
+	cases := []struct {
+		fixture string
+		version int
+		log     bool
+		files   int
+	}{
+		{fixture: "v2", version: 2, files: 1},
+		{fixture: "v6", version: 6, log: true, files: 2},
+	}
+	for _, c := range cases {
+		dataDir := t.TempDir()
+		if err := CreateProfile(dataDir, "misty"); err != nil {
+			t.Errorf("expected no error creating profile, got %v", err)
+			continue
+		}
+		path := ProfilePath(dataDir, "misty")
+		data, _ := os.ReadFile(filepath.Join("testdata", c.fixture+".json"))
+		os.WriteFile(path, data, 0o644)
+		if c.log {
+			logData, _ := os.ReadFile(filepath.Join("testdata", c.fixture+"."+StoreFile))
+			os.WriteFile(StorePath(dataDir, "misty"), logData, 0o644)
+		}
+
+		summary, err := PeekProfile(dataDir, "misty")
+		if err != nil {
+			t.Errorf("%s: expected no error peeking, got %v", c.fixture, err)
+			continue
+		}
+		if summary.Version != c.version || summary.Pokemon != 1 || summary.Stats.Caught != 1 {
+			t.Errorf("%s: expected version %d with 1 pokemon, got %+v", c.fixture, c.version, summary)
+		}
+		after, _ := os.ReadFile(path)
+		if string(after) != string(data) {
+			t.Errorf("%s: expected the save file to be left alone", c.fixture)
+		}
+		entries, _ := os.ReadDir(filepath.Dir(path))
+		if len(entries) != c.files {
+			t.Errorf("%s: expected no new files in the profile, got %d entries", c.fixture, len(entries))
+		}
+	}
+}
@@ -0,0 +1,395 @@
+package save
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// StoreFile is the name of a profile's pokemon log next to its save.json.
+const StoreFile = "pokemon.log"
+
+// StorePath returns the pokemon log of a profile.
+func StorePath(dataDir string, name string) string {
+	return filepath.Join(profileDir(dataDir, name), StoreFile)
+}
+
+// Store keeps caught pokemon in an append-only log with one json encoded
+// operation per line. The log is replayed into memory when the store is
+// opened, building secondary indexes on type, species, location and catch
+// time.
+type Store struct {
+	path    string
+	file    *os.File
+	lock    *os.File
+	records map[int]CaughtPokemon
+	nextUid int
+	entries int
+
+	byType     map[string]map[int]bool
+	bySpecies  map[string]map[int]bool
+	byLocation map[string]map[int]bool
+	// byCaughtAt holds uids ordered by catch time, oldest first
+	byCaughtAt []int
+}
+
+type logEntry struct {
+	Op     string         `json:"op"`
+	Uid    int            `json:"uid"`
+	Record *CaughtPokemon `json:"record,omitempty"`
+}
+
+// ErrStoreLocked is returned when another session has the log open.
+var ErrStoreLocked = errors.New("pokemon log is in use by another session")
+
+const (
+	opPut    = "put"
+	opDelete = "delete"
+	// opMeta records the highest uid handed out, so compacting away
+	// deleted records never frees their uids for reuse
+	opMeta = "meta"
+)
+
+// OpenStore opens or creates the log at path and locks it against other
+// sessions until Close. A half written last line, left behind by a crash
+// during an append, is dropped. An empty path gives a store that only
+// lives in memory.
+func OpenStore(path string) (*Store, error) {
+	s := &Store{
+		path:       path,
+		records:    make(map[int]CaughtPokemon),
+		nextUid:    1,
+		byType:     make(map[string]map[int]bool),
+		bySpecies:  make(map[string]map[int]bool),
+		byLocation: make(map[string]map[int]bool),
+	}
+	if path == "" {
+		return s, nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("error creating pokemon log directory: %w", err)
+	}
+	// the lock lives in its own file, Compact replaces the log itself
+	lock, err := os.OpenFile(path+".lock", os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("error opening pokemon log lock: %w", err)
+	}
+	if err := lockFile(lock); err != nil {
+		lock.Close()
+		return nil, fmt.Errorf("error locking pokemon log: %w", err)
+	}
+	s.lock = lock
+
+	data, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		s.Close()
+		return nil, fmt.Errorf("error reading pokemon log: %w", err)
+	}
+	validLength, err := replayLog(data, s.apply)
+	if err != nil {
+		s.Close()
+		return nil, err
+	}
+	missingNewline := false
+	if validLength > len(data) {
+		// the last entry is complete but misses its newline
+		validLength = len(data)
+		missingNewline = true
+	}
+
+	s.file, err = os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0o600)
+	if err != nil {
+		s.Close()
+		return nil, fmt.Errorf("error opening pokemon log: %w", err)
+	}
+	if err := s.file.Truncate(int64(validLength)); err != nil {
+		s.Close()
+		return nil, fmt.Errorf("error truncating pokemon log: %w", err)
+	}
+	if missingNewline {
+		if _, err := s.file.Write([]byte("\n")); err != nil {
+			s.Close()
+			return nil, fmt.Errorf("error writing pokemon log: %w", err)
+		}
+	}
+
+	if s.entries > 2*len(s.records)+100 {
+		if err := s.Compact(); err != nil {
+			s.Close()
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// replayLog passes every entry of a log to apply and returns the length of
+// the log up to the last complete entry.
+func replayLog(data []byte, apply func(logEntry)) (int, error) {
+	validLength := 0
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		var entry logEntry
+		if err := json.Unmarshal(line, &entry); err != nil {
+			if validLength+len(line) < len(data)-1 {
+				return 0, fmt.Errorf("corrupt pokemon log entry after byte %d: %w", validLength, err)
+			}
+			break
+		}
+		apply(entry)
+		validLength += len(line) + 1
+	}
+	return validLength, nil
+}
+
+// countLog counts the records in a log without opening it for writing.
+func countLog(path string) (int, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("error reading pokemon log: %w", err)
+	}
+	uids := make(map[int]bool)
+	_, err = replayLog(data, func(entry logEntry) {
+		switch {
+		case entry.Op == opPut && entry.Record != nil:
+			uids[entry.Uid] = true
+		case entry.Op != opMeta:
+			delete(uids, entry.Uid)
+		}
+	})
+	return len(uids), err
+}
+
+func (s *Store) apply(entry logEntry) {
+	if entry.Uid >= s.nextUid {
+		s.nextUid = entry.Uid + 1
+	}
+	if entry.Op == opMeta {
+		return
+	}
+	s.entries++
+	if old, ok := s.records[entry.Uid]; ok {
+		s.unindex(old)
+		delete(s.records, entry.Uid)
+	}
+	if entry.Op == opPut && entry.Record != nil {
+		s.records[entry.Uid] = *entry.Record
+		s.index(*entry.Record)
+	}
+}
+
+func (s *Store) index(record CaughtPokemon) {
+	for _, typeName := range record.Types {
+		if s.byType[typeName] == nil {
+			s.byType[typeName] = make(map[int]bool)
+		}
+		s.byType[typeName][record.Uid] = true
+	}
+	species := record.SpeciesName()
+	if s.bySpecies[species] == nil {
+		s.bySpecies[species] = make(map[int]bool)
+	}
+	s.bySpecies[species][record.Uid] = true
+	if record.Location != "" {
+		if s.byLocation[record.Location] == nil {
+			s.byLocation[record.Location] = make(map[int]bool)
+		}
+		s.byLocation[record.Location][record.Uid] = true
+	}
+
+	i := sort.Search(len(s.byCaughtAt), func(i int) bool {
+		return s.records[s.byCaughtAt[i]].CaughtAt.After(record.CaughtAt)
+	})
+	s.byCaughtAt = append(s.byCaughtAt, 0)
+	copy(s.byCaughtAt[i+1:], s.byCaughtAt[i:])
+	s.byCaughtAt[i] = record.Uid
+}
+
+func (s *Store) unindex(record CaughtPokemon) {
+	for _, typeName := range record.Types {
+		delete(s.byType[typeName], record.Uid)
+	}
+	delete(s.bySpecies[record.SpeciesName()], record.Uid)
+	delete(s.byLocation[record.Location], record.Uid)
+	for i, uid := range s.byCaughtAt {
+		if uid == record.Uid {
+			s.byCaughtAt = append(s.byCaughtAt[:i], s.byCaughtAt[i+1:]...)
+			break
+		}
+	}
+}
+
+func (s *Store) append(entry logEntry) error {
+	if s.file == nil {
+		s.apply(entry)
+		return nil
+	}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("error marshalling pokemon log entry: %w", err)
+	}
+	if _, err := s.file.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("error writing pokemon log: %w", err)
+	}
+	if err := s.file.Sync(); err != nil {
+		return fmt.Errorf("error syncing pokemon log: %w", err)
+	}
+	s.apply(entry)
+	return nil
+}
+
+// Put stores a record, assigning it a new uid when it has none yet.
+func (s *Store) Put(record CaughtPokemon) (CaughtPokemon, error) {
+	if record.Uid == 0 {
+		record.Uid = s.nextUid
+	}
+	if err := s.append(logEntry{Op: opPut, Uid: record.Uid, Record: &record}); err != nil {
+		return CaughtPokemon{}, err
+	}
+	return record, nil
+}
+
+func (s *Store) Delete(uid int) error {
+	if _, ok := s.records[uid]; !ok {
+		return nil
+	}
+	return s.append(logEntry{Op: opDelete, Uid: uid})
+}
+
+func (s *Store) Get(uid int) (CaughtPokemon, bool) {
+	record, ok := s.records[uid]
+	return record, ok
+}
+
+func (s *Store) Len() int {
+	return len(s.records)
+}
+
+// All returns every record ordered by uid.
+func (s *Store) All() []CaughtPokemon {
+	uids := []int{}
+	for uid := range s.records {
+		uids = append(uids, uid)
+	}
+	sort.Ints(uids)
+	return s.lookup(uids)
+}
+
+// ByName returns the records of one pokemon, e.g. "vulpix-alola".
+func (s *Store) ByName(name string) []CaughtPokemon {
+	records := []CaughtPokemon{}
+	for _, record := range s.All() {
+		if record.Name == name {
+			records = append(records, record)
+		}
+	}
+	return records
+}
+
+func (s *Store) ByType(typeName string) []CaughtPokemon {
+	return s.lookupSet(s.byType[typeName])
+}
+
+// BySpecies returns the records of a species, including all its forms.
+func (s *Store) BySpecies(species string) []CaughtPokemon {
+	return s.lookupSet(s.bySpecies[species])
+}
+
+// ByLocation returns the records caught in a location area.
+func (s *Store) ByLocation(location string) []CaughtPokemon {
+	return s.lookupSet(s.byLocation[location])
+}
+
+// CaughtBetween returns the records caught in [from, to), oldest first.
+// A zero to means no upper bound.
+func (s *Store) CaughtBetween(from time.Time, to time.Time) []CaughtPokemon {
+	start := sort.Search(len(s.byCaughtAt), func(i int) bool {
+		return !s.records[s.byCaughtAt[i]].CaughtAt.Before(from)
+	})
+	end := len(s.byCaughtAt)
+	if !to.IsZero() {
+		end = sort.Search(len(s.byCaughtAt), func(i int) bool {
+			return !s.records[s.byCaughtAt[i]].CaughtAt.Before(to)
+		})
+	}
+	if end < start {
+		return []CaughtPokemon{}
+	}
+	return s.lookup(s.byCaughtAt[start:end])
+}
+
+func (s *Store) lookupSet(set map[int]bool) []CaughtPokemon {
+	uids := []int{}
+	for uid := range set {
+		uids = append(uids, uid)
+	}
+	sort.Ints(uids)
+	return s.lookup(uids)
+}
+
+func (s *Store) lookup(uids []int) []CaughtPokemon {
+	records := []CaughtPokemon{}
+	for _, uid := range uids {
+		records = append(records, s.records[uid])
+	}
+	return records
+}
+
+// Compact rewrites the log with one put per stored record, dropping
+// overwritten and deleted entries. A leading meta entry keeps the uids of
+// deleted records from being handed out again.
+func (s *Store) Compact() error {
+	if s.file == nil {
+		return nil
+	}
+	var buf bytes.Buffer
+	if s.nextUid > 1 {
+		data, err := json.Marshal(logEntry{Op: opMeta, Uid: s.nextUid - 1})
+		if err != nil {
+			return fmt.Errorf("error marshalling pokemon log entry: %w", err)
+		}
+		buf.Write(append(data, '\n'))
+	}
+	for _, record := range s.All() {
+		data, err := json.Marshal(logEntry{Op: opPut, Uid: record.Uid, Record: &record})
+		if err != nil {
+			return fmt.Errorf("error marshalling pokemon log entry: %w", err)
+		}
+		buf.Write(append(data, '\n'))
+	}
+	if err := writeAtomic(s.path, buf.Bytes()); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(s.path, os.O_RDWR|os.O_APPEND, 0o600)
+	if err != nil {
+		return fmt.Errorf("error opening pokemon log: %w", err)
+	}
+	s.file.Close()
+	s.file = file
+	s.entries = len(s.records)
+	return nil
+}
+
+func (s *Store) Close() error {
+	var err error
+	if s.file != nil {
+		err = s.file.Close()
+	}
+	if s.lock != nil {
+		// closing the lock file releases the lock
+		s.lock.Close()
+	}
+	return err
+}
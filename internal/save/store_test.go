@@ -0,0 +1,175 @@
+package save
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), StoreFile)
+	store, err := OpenStore(path)
+	if err != nil {
+		t.Errorf("expected no error opening store, got %v", err)
+		return
+	}
+
+	day := func(d int) time.Time {
+		return time.Date(2026, 10, d, 12, 0, 0, 0, time.UTC)
+	}
+	records := []CaughtPokemon{
+		{Name: "charmander", Species: "charmander", Types: []string{"fire"}, CaughtAt: day(3), Location: "route-1-area"},
+		{Name: "vulpix", Species: "vulpix", Types: []string{"fire"}, CaughtAt: day(1), Location: "route-7-area"},
+		{Name: "vulpix-alola", Species: "vulpix", Types: []string{"ice"}, CaughtAt: day(2), Location: "route-7-area"},
+	}
+	for _, record := range records {
+		if _, err := store.Put(record); err != nil {
+			t.Errorf("expected no error putting %s, got %v", record.Name, err)
+		}
+	}
+	if err := store.Delete(1); err != nil {
+		t.Errorf("expected no error deleting, got %v", err)
+	}
+	store.Close()
+
+	store, err = OpenStore(path)
+	if err != nil {
+		t.Errorf("expected no error reopening store, got %v", err)
+		return
+	}
+	defer store.Close()
+
+	if store.Len() != 2 {
+		t.Errorf("expected 2 records after replay, got %d", store.Len())
+	}
+	if fire := store.ByType("fire"); len(fire) != 1 || fire[0].Name != "vulpix" {
+		t.Errorf("expected only vulpix to be fire type, got %+v", fire)
+	}
+	if vulpix := store.BySpecies("vulpix"); len(vulpix) != 2 {
+		t.Errorf("expected 2 vulpix, got %+v", vulpix)
+	}
+	if route7 := store.ByLocation("route-7-area"); len(route7) != 2 || route7[0].Name != "vulpix" {
+		t.Errorf("expected both vulpix from route-7-area, got %+v", route7)
+	}
+	if route1 := store.ByLocation("route-1-area"); len(route1) != 0 {
+		t.Errorf("expected the deleted charmander to leave the location index, got %+v", route1)
+	}
+	moved := store.ByLocation("route-7-area")[1]
+	moved.Location = "mt-moon-1f"
+	store.Put(moved)
+	if route7 := store.ByLocation("route-7-area"); len(route7) != 1 {
+		t.Errorf("expected an updated record to be reindexed, got %+v", route7)
+	}
+	caught := store.CaughtBetween(day(2), time.Time{})
+	if len(caught) != 1 || caught[0].Name != "vulpix-alola" {
+		t.Errorf("expected only vulpix-alola caught since day 2, got %+v", caught)
+	}
+	caught = store.CaughtBetween(time.Time{}, day(3))
+	if len(caught) != 2 || caught[0].Name != "vulpix" {
+		t.Errorf("expected vulpix then vulpix-alola before day 3, got %+v", caught)
+	}
+
+	record, _ := store.Put(CaughtPokemon{Name: "growlithe", Species: "growlithe", Types: []string{"fire"}})
+	if record.Uid != 4 {
+		t.Errorf("expected uids to never be reused, got %d", record.Uid)
+	}
+}
+
+func TestStoreTornWrite(t *testing.T) {
+	path := filepath.Join(t.TempDir(), StoreFile)
+	store, _ := OpenStore(path)
+	store.Put(CaughtPokemon{Name: "pidgey", Species: "pidgey", Types: []string{"normal", "flying"}})
+	store.Close()
+
+	file, _ := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o600)
+	file.Write([]byte(`{"op":"put","uid":2,"rec`))
+	file.Close()
+
+	store, err := OpenStore(path)
+	if err != nil {
+		t.Errorf("expected a torn last entry to be dropped, got %v", err)
+		return
+	}
+	store.Put(CaughtPokemon{Name: "rattata", Species: "rattata", Types: []string{"normal"}})
+	store.Close()
+
+	store, err = OpenStore(path)
+	if err != nil {
+		t.Errorf("expected no error reopening store, got %v", err)
+		return
+	}
+	defer store.Close()
+	if store.Len() != 2 {
+		t.Errorf("expected pidgey and rattata, got %+v", store.All())
+	}
+}
+
+func TestStoreCompact(t *testing.T) {
+	path := filepath.Join(t.TempDir(), StoreFile)
+	store, _ := OpenStore(path)
+	record, _ := store.Put(CaughtPokemon{Name: "magikarp", Species: "magikarp", Types: []string{"water"}})
+	for range 10 {
+		store.Put(record)
+	}
+	if err := store.Compact(); err != nil {
+		t.Errorf("expected no error compacting, got %v", err)
+	}
+	store.Put(CaughtPokemon{Name: "gyarados", Species: "gyarados", Types: []string{"water", "flying"}})
+	store.Close()
+
+	store, _ = OpenStore(path)
+	defer store.Close()
+	if store.entries != 2 || store.Len() != 2 {
+		t.Errorf("expected 2 entries after compaction, got %d entries for %d records", store.entries, store.Len())
+	}
+}
+
+func TestStoreLocked(t *testing.T) {
+	path := filepath.Join(t.TempDir(), StoreFile)
+	store, err := OpenStore(path)
+	if err != nil {
+		t.Errorf("expected no error opening store, got %v", err)
+		return
+	}
+	store.Compact()
+	if _, err := OpenStore(path); !errors.Is(err, ErrStoreLocked) {
+		t.Errorf("expected ErrStoreLocked while the store is open, got %v", err)
+	}
+	store.Close()
+
+	store, err = OpenStore(path)
+	if err != nil {
+		t.Errorf("expected no error reopening a closed store, got %v", err)
+		return
+	}
+	store.Close()
+}
+
+func TestStoreUidsSurviveCompact(t *testing.T) {
+	path := filepath.Join(t.TempDir(), StoreFile)
+	store, _ := OpenStore(path)
+	for _, name := range []string{"pidgey", "rattata", "spearow"} {
+		store.Put(CaughtPokemon{Name: name, Species: name})
+	}
+	store.Delete(3)
+	if err := store.Compact(); err != nil {
+		t.Errorf("expected no error compacting, got %v", err)
+	}
+	store.Close()
+
+	store, err := OpenStore(path)
+	if err != nil {
+		t.Errorf("expected no error reopening store, got %v", err)
+		return
+	}
+	defer store.Close()
+	if store.Len() != 2 || store.entries != 2 {
+		t.Errorf("expected 2 records in 2 entries, got %d in %d", store.Len(), store.entries)
+	}
+	record, _ := store.Put(CaughtPokemon{Name: "ekans", Species: "ekans"})
+	if record.Uid != 4 {
+		t.Errorf("expected the released uid 3 not to be reused, got %d", record.Uid)
+	}
+}
@@ -0,0 +1,270 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/4mewes/pokedex/internal/pokeapi"
+	"github.com/4mewes/pokedex/internal/save"
+)
+
+const defaultLang = "en"
+
+// localizedIndexWorkers bounds the requests made at once while building a
+// name index.
+const localizedIndexWorkers = 16
+
+// localizedEndpoints are the endpoints `lang index` collects localized
+// names of, and where the names come from. Pokemon have no names of their
+// own and go through their species.
+var localizedEndpoints = map[string]string{
+	"pokemon":         "pokemon-species",
+	"pokemon-species": "pokemon-species",
+	"move":            "move",
+	"item":            "item",
+}
+
+// localizedName picks the name for conf.lang out of a resource's names and
+// remembers it, so the localized name is accepted as input afterwards.
+// English keeps showing api slugs, which is what every command takes.
+func localizedName(conf *config, names []pokeapi.Names, slug string) string {
+	if conf.lang == "" || conf.lang == defaultLang {
+		return slug
+	}
+	for _, name := range names {
+		if name.Language.Name == conf.lang {
+			if conf.localizedNames == nil {
+				conf.localizedNames = make(map[string]string)
+			}
+			conf.localizedNames[strings.ToLower(name.Name)] = slug
+			return name.Name
+		}
+	}
+	return slug
+}
+
+// fetchNames returns the localized names of one resource.
+func fetchNames(conf *config, endpoint string, slug string) ([]pokeapi.Names, error) {
+	url := "https://pokeapi.co/api/v2/" + endpoint + "/" + slug + "/"
+	switch endpoint {
+	case "pokemon-species":
+		pokemonSpeciesInfoRes, err := pokeapi.GetPokemonSpeciesInfo(url, conf.cache)
+		return pokemonSpeciesInfoRes.Names, err
+	case "move":
+		moveInfoRes, err := pokeapi.GetMoveInfo(url, conf.cache)
+		return moveInfoRes.Names, err
+	case "item":
+		itemInfoRes, err := pokeapi.GetItemInfo(url, conf.cache)
+		return itemInfoRes.Names, err
+	case "location":
+		locationInfoRes, err := pokeapi.GetLocationInfo(url, conf.cache)
+		return locationInfoRes.Names, err
+	case "location-area":
+		locationAreaInfoRes, err := pokeapi.GetLocationAreaInfo(url, conf.cache)
+		return locationAreaInfoRes.Names, err
+	case "region":
+		regionInfoRes, err := pokeapi.GetRegionInfo(url, conf.cache)
+		return regionInfoRes.Names, err
+	}
+	return nil, fmt.Errorf("%s has no localized names", endpoint)
+}
+
+// resourceDisplayName localizes the name of a single resource, fetching it
+// when a language other than english is selected.
+func resourceDisplayName(conf *config, endpoint string, slug string) string {
+	if conf.lang == "" || conf.lang == defaultLang || slug == "" {
+		return slug
+	}
+	names, err := fetchNames(conf, endpoint, slug)
+	if err != nil {
+		return slug
+	}
+	return localizedName(conf, names, slug)
+}
+
+// localizedIndex returns the saved name index of conf.lang, loading it once
+// per session. It stays empty until `lang index` builds it.
+func localizedIndex(conf *config) save.NameIndex {
+	if index, ok := conf.localizedIndex[conf.lang]; ok {
+		return index
+	}
+	index := save.NameIndex{}
+	if conf.dataDir != "" {
+		loaded, err := save.LoadNameIndex(conf.dataDir, conf.lang)
+		if err != nil {
+			fmt.Println(err)
+		} else {
+			index = loaded
+		}
+	}
+	if conf.localizedIndex == nil {
+		conf.localizedIndex = make(map[string]save.NameIndex)
+	}
+	conf.localizedIndex[conf.lang] = index
+	return index
+}
+
+// buildLocalizedIndex fetches the names of every resource of an endpoint
+// and collects the ones in conf.lang.
+func buildLocalizedIndex(conf *config, endpoint string) (map[string]string, error) {
+	slugs, err := nameIndex(conf, endpoint)
+	if err != nil {
+		return nil, err
+	}
+	index := make(map[string]string)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	queue := make(chan string)
+	for range localizedIndexWorkers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for slug := range queue {
+				names, err := fetchNames(conf, endpoint, slug)
+				if err != nil {
+					// a missing name only means it can't be typed localized
+					continue
+				}
+				mu.Lock()
+				for _, name := range names {
+					if name.Language.Name == conf.lang {
+						index[strings.ToLower(name.Name)] = slug
+					}
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	for _, slug := range slugs {
+		queue <- slug
+	}
+	close(queue)
+	wg.Wait()
+	return index, nil
+}
+
+// localizedCandidates maps the localized names accepted as input for an
+// endpoint to their slugs: names displayed this session and the saved
+// name index.
+func localizedCandidates(conf *config, endpoint string) map[string]string {
+	candidates := make(map[string]string)
+	if conf.lang == "" || conf.lang == defaultLang {
+		return candidates
+	}
+	if indexEndpoint, ok := localizedEndpoints[endpoint]; ok {
+		for name, slug := range localizedIndex(conf)[indexEndpoint] {
+			candidates[name] = slug
+		}
+	}
+	for name, slug := range conf.localizedNames {
+		candidates[name] = slug
+	}
+	return candidates
+}
+
+// localizedSlug resolves a name in conf.lang to the slug of a resource of
+// the given endpoint, e.g. "glurak" to "charizard".
+func localizedSlug(conf *config, endpoint string, input string) (string, bool) {
+	slug, ok := localizedCandidates(conf, endpoint)[input]
+	return slug, ok
+}
+
+// pokemonDisplayName localizes a pokemon name through its species. Forms
+// without a species of their own keep their slug.
+func pokemonDisplayName(conf *config, pokemonName string) string {
+	if conf.lang == "" || conf.lang == defaultLang {
+		return pokemonName
+	}
+	url := "https://pokeapi.co/api/v2/pokemon-species/" + pokemonName + "/"
+	pokemonSpeciesInfoRes, err := pokeapi.GetPokemonSpeciesInfo(url, conf.cache)
+	if err != nil {
+		return pokemonName
+	}
+	return localizedName(conf, pokemonSpeciesInfoRes.Names, pokemonName)
+}
+
+// localizedEffect returns the short effect in conf.lang, falling back to
+// english since most effects are only translated there.
+func localizedEffect(conf *config, entries []pokeapi.EffectEntries) string {
+	effects := make(map[string]string)
+	for _, entry := range entries {
+		effect := entry.ShortEffect
+		if effect == "" {
+			effect = entry.Effect
+		}
+		effects[entry.Language.Name] = effect
+	}
+	if effect, ok := effects[conf.lang]; ok {
+		return effect
+	}
+	return effects[defaultLang]
+}
+
+func commandLang(conf *config, args ...string) error {
+	if len(args) == 0 {
+		fmt.Printf("Current language: %s\n", conf.lang)
+		return nil
+	}
+	if args[0] == "index" {
+		return commandLangIndex(conf)
+	}
+	url := "https://pokeapi.co/api/v2/language/?limit=100"
+	languageListRes, err := pokeapi.GetResourceList(url, conf.cache)
+	if err != nil {
+		fmt.Println("error in GetResourceList: %w", err)
+		return fmt.Errorf("error in GetResourceList: %w", err)
+	}
+	for _, language := range languageListRes.Results {
+		if language.Name == args[0] {
+			conf.lang = language.Name
+			fmt.Printf("Language set to %s\n", conf.lang)
+			if err := writeProfile(conf); err != nil {
+				fmt.Println(err)
+			}
+			return nil
+		}
+	}
+
+	codes := []string{}
+	for _, language := range languageListRes.Results {
+		codes = append(codes, language.Name)
+	}
+	fmt.Printf("unknown language %s, pick one of: %s\n", args[0], strings.Join(codes, ", "))
+	return nil
+}
+
+// commandLangIndex collects the localized names of every pokemon, move and
+// item, so they are accepted as input before they were ever displayed. It
+// fetches thousands of resources, so it only runs when asked to and the
+// index is saved for later sessions.
+func commandLangIndex(conf *config) error {
+	if conf.lang == "" || conf.lang == defaultLang {
+		fmt.Println("english names are the api names, there is nothing to index")
+		return nil
+	}
+	index := save.NameIndex{}
+	for _, endpoint := range []string{"pokemon-species", "move", "item"} {
+		fmt.Printf("Collecting %s names in %s...\n", endpoint, conf.lang)
+		names, err := buildLocalizedIndex(conf, endpoint)
+		if err != nil {
+			fmt.Println("error in buildLocalizedIndex: %w", err)
+			return fmt.Errorf("error in buildLocalizedIndex: %w", err)
+		}
+		index[endpoint] = names
+	}
+	if conf.localizedIndex == nil {
+		conf.localizedIndex = make(map[string]save.NameIndex)
+	}
+	conf.localizedIndex[conf.lang] = index
+	if conf.dataDir == "" {
+		fmt.Println("no data directory, the index only lasts for this session")
+		return nil
+	}
+	if err := save.WriteNameIndex(conf.dataDir, conf.lang, index); err != nil {
+		fmt.Println(err)
+		return nil
+	}
+	fmt.Printf("Saved %s names to %s\n", conf.lang, save.NameIndexPath(conf.dataDir, conf.lang))
+	return nil
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/4mewes/pokedex/internal/save"
+)
+
+func TestLocalizedSlug(t *testing.T) {
+	conf := &config{
+		lang: "de",
+		localizedIndex: map[string]save.NameIndex{
+			"de": {
+				"pokemon-species": {"glurak": "charizard"},
+				"item":            {"trank": "potion"},
+			},
+		},
+		localizedNames: map[string]string{"vertania-wald": "viridian-forest"},
+	}
+	cases := []struct {
+		endpoint string
+		input    string
+		expected string
+		ok       bool
+	}{
+		{endpoint: "pokemon", input: "glurak", expected: "charizard", ok: true},
+		{endpoint: "pokemon-species", input: "glurak", expected: "charizard", ok: true},
+		{endpoint: "item", input: "trank", expected: "potion", ok: true},
+		{endpoint: "item", input: "glurak", ok: false},
+		{endpoint: "berry", input: "trank", ok: false},
+		{endpoint: "location", input: "vertania-wald", expected: "viridian-forest", ok: true},
+	}
+
+	for _, c := range cases {
+		actual, ok := localizedSlug(conf, c.endpoint, c.input)
+		if actual != c.expected || ok != c.ok {
+			t.Errorf("localizedSlug(%s, %s) = %s, %v, expected %s, %v", c.endpoint, c.input, actual, ok, c.expected, c.ok)
+		}
+	}
+
+	conf.lang = defaultLang
+	if _, ok := localizedSlug(conf, "pokemon", "glurak"); ok {
+		t.Errorf("expected no localized lookup in english")
+	}
+}
+
+func TestResolveLocalizedName(t *testing.T) {
+	conf := &config{
+		lang:      "de",
+		nameIndex: map[string][]string{"pokemon": {"charizard", "charmander", "pikachu"}},
+		localizedIndex: map[string]save.NameIndex{
+			"de": {"pokemon-species": {"glurak": "charizard", "glumanda": "charmander", "pikachu": "pikachu"}},
+		},
+	}
+	cases := []struct {
+		input    string
+		expected string
+		ok       bool
+	}{
+		{input: "pikachu", expected: "pikachu", ok: true},
+		{input: "glurak", expected: "charizard", ok: true},
+		{input: "glurack", expected: "charizard", ok: true},
+		{input: "charizrd", expected: "charizard", ok: true},
+		{input: "mewtu", ok: false},
+	}
+
+	for _, c := range cases {
+		actual, ok := resolveName(conf, "pokemon", c.input)
+		if actual != c.expected || ok != c.ok {
+			t.Errorf("resolveName(%s) = %s, %v, expected %s, %v", c.input, actual, ok, c.expected, c.ok)
+		}
+	}
+}
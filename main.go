@@ -2,20 +2,28 @@ package main
 
 import (
 	"bufio"
+	"flag"
 	"fmt"
 	"math/rand"
 	"os"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/4mewes/pokedex/internal/pokecache"
 	"github.com/4mewes/pokedex/internal/pokeapi"
+	"github.com/4mewes/pokedex/internal/save"
 )
 
 const MaxBaseExp = 255
 
 func commandExit(conf *config, args ...string) error {
 	fmt.Println("Closing the Pokedex... Goodbye!")
+	if err := writeProfile(conf); err != nil {
+		fmt.Println(err)
+	}
+	conf.pokedex.Close()
 	os.Exit(0)
 	return nil
 }
@@ -44,7 +52,7 @@ func commandMap(conf *config, args ...string) error {
 	conf.next = locationAreaRes.Next
 	conf.previous = locationAreaRes.Previous
 	for _, location := range locationAreaRes.Results {
-		fmt.Println(location.Name)
+		fmt.Println(resourceDisplayName(conf, "location-area", location.Name))
 	}
 	return nil
 }
@@ -67,19 +75,29 @@ func commandMapb(conf *config, args ...string) error {
 	conf.next = locationAreaRes.Next
 	conf.previous = locationAreaRes.Previous
 	for _, location := range locationAreaRes.Results {
-		fmt.Println(location.Name)
+		fmt.Println(resourceDisplayName(conf, "location-area", location.Name))
 	}
 	return nil
 }
 
 func commandExplore(conf *config, args ...string) error {
+	args, flags := parseFlags(args, "detail")
 	var locationAreaName string
 	if len(args) == 0 {
 		fmt.Println("please provide a location area name arg!")
 		return nil
 	} else {
-		locationAreaName = args[0]
+		var ok bool
+		locationAreaName, ok = resolveName(conf, "location-area", args[0])
+		if !ok {
+			return nil
+		}
+	}
+	version := flags["version"]
+	if version == "" {
+		version = conf.game
 	}
+	_, detail := flags["detail"]
 
 	fmt.Printf("Exploring %s...\n", locationAreaName)
 	url := "https://pokeapi.co/api/v2/location-area/" + locationAreaName + "/"
@@ -89,19 +107,116 @@ func commandExplore(conf *config, args ...string) error {
 		fmt.Println("error in getlocationAreaInfo: %w", err)
 		return fmt.Errorf("error in getLocationAreaInfo: %w", err)
 	}
+	conf.lastExplored = locationAreaInfoRes.Name
+	conf.exploredLevels = wildLevels(locationAreaInfoRes, version)
+	if conf.lang != defaultLang {
+		fmt.Printf("(%s)\n", localizedName(conf, locationAreaInfoRes.Names, locationAreaInfoRes.Name))
+	}
+
+	if detail {
+		fmt.Println("Encounter method rates:")
+		for _, methodRates := range locationAreaInfoRes.EncounterMethodRates {
+			rates := []string{}
+			for _, versionDetails := range methodRates.VersionDetails {
+				if version != "" && versionDetails.Version.Name != version {
+					continue
+				}
+				rates = append(rates, fmt.Sprintf("%s %d%%", versionDetails.Version.Name, versionDetails.Rate))
+			}
+			if len(rates) > 0 {
+				fmt.Printf("- %s: %s\n", methodRates.EncounterMethod.Name, strings.Join(rates, ", "))
+			}
+		}
+	}
+
+	speciesNames, err := nameIndex(conf, "pokemon-species")
+	if err != nil {
+		fmt.Println(err)
+	}
 	fmt.Println("Found Pokemon:")
 	for _, PokemonEncounters := range locationAreaInfoRes.PokemonEncounters {
-		fmt.Printf("- %s\n", PokemonEncounters.Pokemon.Name)
+		versionDetails := []pokeapi.EncounterVersionDetails{}
+		for _, details := range PokemonEncounters.VersionDetails {
+			if version == "" || details.Version.Name == version {
+				versionDetails = append(versionDetails, details)
+			}
+		}
+		if len(versionDetails) == 0 {
+			continue
+		}
+
+		// most encounters are named after their species, only forms need
+		// their pokemon fetched to find it
+		if slices.Contains(speciesNames, PokemonEncounters.Pokemon.Name) {
+			conf.seen[PokemonEncounters.Pokemon.Name] = true
+		} else if pokemonInfoRes, err := pokeapi.GetPokemonInfo(PokemonEncounters.Pokemon.Url, conf.cache); err != nil {
+			fmt.Println("error in GetPokemonInfo: %w", err)
+		} else {
+			markSeen(conf, pokemonInfoRes)
+		}
+		fmt.Printf("- %s\n", pokemonDisplayName(conf, PokemonEncounters.Pokemon.Name))
+		if !detail {
+			continue
+		}
+		for _, details := range versionDetails {
+			fmt.Printf("    %s (up to %d%%):\n", details.Version.Name, details.MaxChance)
+			for _, encounterDetails := range details.EncounterDetails {
+				fmt.Printf("      - %s\n", formatEncounterDetails(encounterDetails))
+			}
+		}
+	}
+	if err := writeProfile(conf); err != nil {
+		fmt.Println(err)
 	}
 	return nil
 }
 
+// markSeen records a pokemon's species as seen, so forms like
+// deoxys-normal count towards their species' dex entry.
+func markSeen(conf *config, pokemon pokeapi.PokemonInfo) {
+	if pokemon.Species.Name != "" {
+		conf.seen[pokemon.Species.Name] = true
+	} else {
+		conf.seen[pokemon.Name] = true
+	}
+}
+
+// wildLevels collects the level range each pokemon is found at in an area.
+func wildLevels(locationAreaInfo pokeapi.LocationAreaInfo, version string) map[string]levelRange {
+	levels := make(map[string]levelRange)
+	for _, encounter := range locationAreaInfo.PokemonEncounters {
+		for _, versionDetails := range encounter.VersionDetails {
+			if version != "" && versionDetails.Version.Name != version {
+				continue
+			}
+			for _, details := range versionDetails.EncounterDetails {
+				levels[encounter.Pokemon.Name] = levels[encounter.Pokemon.Name].extend(details.MinLevel, details.MaxLevel)
+			}
+		}
+	}
+	return levels
+}
+
+// wildLevel rolls the level of a caught pokemon, within the range it is
+// found at in the last explored area when it lives there.
+func wildLevel(conf *config, pokemon string) int {
+	levels, ok := conf.exploredLevels[pokemon]
+	if !ok || levels.min < 1 {
+		return rand.Intn(100) + 1
+	}
+	return levels.min + rand.Intn(levels.max-levels.min+1)
+}
+
 func commandCatch(conf *config, args ...string) error {
+	args, flags := parseFlags(args)
 	if len(args) == 0 {
 		fmt.Println("missing required parameter: pokemon name")
 		return nil
 	}
-	pokemon := args[0]
+	pokemon, ok := resolvePokemonName(conf, args[0])
+	if !ok {
+		return nil
+	}
 	url := "https://pokeapi.co/api/v2/pokemon/" + pokemon + "/"
 
 	pokemonInfoRes, err := pokeapi.GetPokemonInfo(url, conf.cache)
@@ -111,30 +226,61 @@ func commandCatch(conf *config, args ...string) error {
 	}
 
 	baseExperience := pokemonInfoRes.BaseExperience
+	markSeen(conf, pokemonInfoRes)
 	fmt.Printf("Throwing a Pokeball at %s...\n", pokemon)
+	conf.stats.Throws++
+	if conf.throws == nil {
+		conf.throws = make(map[string]int)
+	}
+	conf.throws[pokemon]++
 	if rand.Intn(MaxBaseExp) >= baseExperience {
-		if conf.pokedex == nil {
-			conf.pokedex = make(map[string]pokeapi.PokemonInfo)
+		record := save.NewCaughtPokemon(pokemonInfoRes)
+		record.CaughtAt = time.Now()
+		record.Location = conf.lastExplored
+		record.Throws = conf.throws[pokemon]
+		record.Level = wildLevel(conf, pokemon)
+		record.Nickname = flags["nickname"]
+		if nickname := rawFlag(conf.input, "nickname"); nickname != "" {
+			record.Nickname = nickname
+		}
+		saved, err := conf.pokedex.Put(record)
+		if err != nil {
+			fmt.Println(err)
+			return nil
 		}
-		conf.pokedex[pokemon] = pokemonInfoRes
-		fmt.Printf("%s was caught!\n", pokemon)
+		record = saved
+		delete(conf.throws, pokemon)
+		conf.caught[record.SpeciesName()] = true
+		conf.stats.Caught++
+		fmt.Printf("%s was caught at level %d and sent to your box as #%d!\n", pokemon, record.Level, record.Uid)
 	} else {
+		conf.stats.Escaped++
 		fmt.Printf("%s escaped!\n", pokemon)
 	}
+	if err := writeProfile(conf); err != nil {
+		fmt.Println(err)
+	}
 
 	return nil
 }
 
 func printPokemonInfoFromPokedex(conf *config, pokemonName string) error {
-	pokemon, ok := conf.pokedex[pokemonName]
+	records, pokemon, ok := pokedexEntry(conf, pokemonName)
 	if !ok {
-		fmt.Printf("%s is not in your pokedex!\n", pokemonName)
+		fmt.Printf("%s is not in your box!\n", pokemonName)
 		return nil
 	}
 
-	fmt.Printf("Name: %s\n", pokemon.Name)
+	fmt.Printf("Name: %s\n", pokemonDisplayName(conf, pokemon.Name))
+	fmt.Printf("In your box:\n")
+	for _, record := range records {
+		fmt.Printf("  - %s\n", boxEntry(conf, record))
+	}
 	fmt.Printf("Height: %d\n", pokemon.Height)
 	fmt.Printf("Weight: %d\n", pokemon.Weight)
+	if sprite := spriteForGame(conf, pokemon.Sprites); sprite != "" {
+		fmt.Printf("Sprite: %s\n", sprite)
+	}
 	fmt.Printf("Stats:\n")
 	for i := range len(pokemon.Stats) {
 		fmt.Printf("  - %s: %d\n", pokemon.Stats[i].Stat.Name, pokemon.Stats[i].BaseStat)
@@ -144,20 +290,153 @@ func printPokemonInfoFromPokedex(conf *config, pokemonName string) error {
 	for i := range len(pokemon.Types) {
 		fmt.Printf("  - %s\n", pokemon.Types[i].Type.Name)
 	}
+
+	if err := printVarietyDifferences(conf, pokemon); err != nil {
+		fmt.Println("error in printVarietyDifferences: %w", err)
+	}
+
+	if len(pokemon.HeldItems) > 0 {
+		fmt.Printf("Held Items: \n")
+		for _, heldItem := range pokemon.HeldItems {
+			rarities := []string{}
+			for _, versionDetails := range heldItem.VersionDetails {
+				if conf.game != "" && versionDetails.Version.Name != conf.game {
+					continue
+				}
+				rarities = append(rarities, fmt.Sprintf("%s %d%%", versionDetails.Version.Name, versionDetails.Rarity))
+			}
+			if len(rarities) > 0 {
+				fmt.Printf("  - %s: %s\n", heldItem.Item.Name, strings.Join(rarities, ", "))
+			}
+		}
+	}
+
+	fmt.Printf("Abilities: \n")
+	printAbilities(pokemon.Abilities, "  ")
+	if len(pokemon.PastAbilities) > 0 {
+		fmt.Printf("Past Abilities: \n")
+		for _, pastAbilities := range pokemon.PastAbilities {
+			fmt.Printf("  up to %s:\n", pastAbilities.Generation.Name)
+			printAbilities(pastAbilitySet(pokemon.Abilities, pokemon.PastAbilities, generationNumber(pastAbilities.Generation)), "    ")
+		}
+	}
 	return nil
 }
 
+// boxEntry describes one individual in the box, e.g.
+// "#3 Sparky, level 12, caught 2026-01-31 15:04 in viridian-forest-area after 2 throws".
+func boxEntry(conf *config, caught save.CaughtPokemon) string {
+	line := fmt.Sprintf("#%d", caught.Uid)
+	if caught.Nickname != "" {
+		line += " " + caught.Nickname
+	}
+	if caught.Level > 0 {
+		line += fmt.Sprintf(", level %d", caught.Level)
+	}
+	if caught.CaughtAt.IsZero() {
+		return line
+	}
+	line += ", caught " + caught.CaughtAt.Local().Format("2006-01-02 15:04")
+	if caught.Location != "" {
+		line += " in " + resourceDisplayName(conf, "location-area", caught.Location)
+	}
+	if caught.Throws == 1 {
+		line += " with the first throw"
+	} else if caught.Throws > 1 {
+		line += fmt.Sprintf(" after %d throws", caught.Throws)
+	}
+	return line
+}
+
+func printAbilities(abilities []pokeapi.Abilities, indent string) {
+	for _, ability := range abilities {
+		if ability.Ability.Name == "" {
+			// past abilities list empty slots for abilities that did not exist yet
+			continue
+		}
+		if ability.IsHidden {
+			fmt.Printf("%s- %s (hidden)\n", indent, ability.Ability.Name)
+		} else {
+			fmt.Printf("%s- %s\n", indent, ability.Ability.Name)
+		}
+	}
+}
+
 func commandInspect(conf *config, args ...string) error {
-	if conf.pokedex == nil || len(conf.pokedex) == 0 {
-		fmt.Println("Your pokedex is empty. Catch some pokemon first!")
+	args, flags := parseFlags(args)
+	if conf.pokedex.Len() == 0 {
+		fmt.Println("Your box is empty. Catch some pokemon first!")
 		return nil
 	}
 	if len(args) == 0 {
 		fmt.Println("please provide a pokemon name to inspect")
 		return nil
 	}
-	pokemonName := args[0]
-	return printPokemonInfoFromPokedex(conf, pokemonName)
+	for _, record := range conf.pokedex.All() {
+		if strconv.Itoa(record.Id) == args[0] {
+			args[0] = record.Name
+		}
+	}
+	if !slices.Contains(caughtNames(conf), args[0]) {
+		if slug, ok := localizedSlug(conf, "pokemon", args[0]); ok {
+			args[0] = slug
+		}
+	}
+	pokemonName, ok := resolveInCandidates(args[0], caughtNames(conf))
+	if !ok {
+		return nil
+	}
+	err := printPokemonInfoFromPokedex(conf, pokemonName)
+	if err != nil {
+		return err
+	}
+
+	natureName, hasNature := flags["nature"]
+	levelArg, hasLevel := flags["level"]
+	if !hasNature && !hasLevel {
+		return nil
+	}
+	records, pokemon, ok := pokedexEntry(conf, pokemonName)
+	if !ok {
+		return nil
+	}
+
+	// with several individuals in the box there is no single caught level
+	level := 50
+	if len(records) == 1 && records[0].Level > 0 {
+		level = records[0].Level
+	}
+	if hasLevel {
+		level, err = strconv.Atoi(levelArg)
+		if err != nil || level < 1 || level > 100 {
+			fmt.Println("--level must be a number between 1 and 100")
+			return nil
+		}
+	}
+	nature := pokeapi.NatureInfo{}
+	if hasNature {
+		natureName, ok = resolveName(conf, "nature", natureName)
+		if !ok {
+			return nil
+		}
+		url := "https://pokeapi.co/api/v2/nature/" + natureName + "/"
+		nature, err = pokeapi.GetNatureInfo(url, conf.cache)
+		if err != nil {
+			fmt.Println("error in GetNatureInfo: %w", err)
+			return fmt.Errorf("error in GetNatureInfo: %w", err)
+		}
+	}
+
+	if nature.Name != "" {
+		fmt.Printf("Stats at level %d with a %s nature:\n", level, nature.Name)
+	} else {
+		fmt.Printf("Stats at level %d:\n", level)
+	}
+	for _, stat := range pokemon.Stats {
+		actual := calculateStat(stat.Stat.Name, stat.BaseStat, level, natureModifier(nature, stat.Stat.Name))
+		fmt.Printf("  - %s: %d\n", stat.Stat.Name, actual)
+	}
+	return nil
 }
 
 type cliCommand struct {
@@ -166,11 +445,45 @@ type cliCommand struct {
 	callback    func(*config, ...string) error
 }
 
+type levelRange struct {
+	min int
+	max int
+}
+
+// extend widens the range to include low..high.
+func (r levelRange) extend(low int, high int) levelRange {
+	if r.min == 0 || low < r.min {
+		r.min = low
+	}
+	if high > r.max {
+		r.max = high
+	}
+	return r
+}
+
 type config struct {
-	next     string
-	previous string
-	cache    *pokecache.Cache
-	pokedex  map[string]pokeapi.PokemonInfo
+	next           string
+	previous       string
+	cache          *pokecache.Cache
+	pokedex        *save.Store
+	game           string
+	versionGroup   string
+	generation     string
+	lang           string
+	localizedNames map[string]string
+	localizedIndex map[string]save.NameIndex
+	nameIndex      map[string][]string
+	dataDir        string
+	profile        string
+	stats          save.Stats
+	lastExplored   string
+	exploredLevels map[string]levelRange
+	throws         map[string]int
+	caught         map[string]bool
+	seen           map[string]bool
+	party          []int
+	// input is the raw input line, for free text that keeps its case
+	input string
 }
 
 var commandRegistry = map[string]cliCommand{}
@@ -199,26 +512,179 @@ func main() {
 		},
 		"explore": {
 			name:        "explore",
-			description: "List Pokemon in given location",
+			description: "List Pokemon in given location, optionally --version <game> and --detail",
 			callback:    commandExplore,
 		},
 		"catch": {
 			name:        "catch",
-			description: "attampt to catch a pokemon",
+			description: "attampt to catch a pokemon, optionally --nickname <name>",
 			callback:    commandCatch,
 		},
 		"inspect": {
 			name:        "inspect",
-			description: "inspect your pokedex",
+			description: "inspect your pokedex, optionally --nature <nature> --level <level> for actual stats",
 			callback:    commandInspect,
 		},
+		"ability": {
+			name:        "ability",
+			description: "show an ability and every pokemon that can have it",
+			callback:    commandAbility,
+		},
+		"whereis": {
+			name:        "whereis",
+			description: "list where to find a pokemon, optionally --version <game>",
+			callback:    commandWhereis,
+		},
+		"regions": {
+			name:        "regions",
+			description: "list all regions",
+			callback:    commandRegions,
+		},
+		"region": {
+			name:        "region",
+			description: "list the locations within a region",
+			callback:    commandRegion,
+		},
+		"location": {
+			name:        "location",
+			description: "list the explorable areas of a location",
+			callback:    commandLocation,
+		},
+		"item": {
+			name:        "item",
+			description: "show an item's cost, category and effect",
+			callback:    commandItem,
+		},
+		"berry": {
+			name:        "berry",
+			description: "show a berry's growth, size and flavors",
+			callback:    commandBerry,
+		},
+		"berries": {
+			name:        "berries",
+			description: "list berries, optionally --flavor <flavor> and --sort name|potency|growth|harvest|size|smoothness|power",
+			callback:    commandBerries,
+		},
+		"nature": {
+			name:        "nature",
+			description: "show which stats and flavors a nature affects",
+			callback:    commandNature,
+		},
+		"game": {
+			name:        "game",
+			description: "select the game version every lookup is scoped to, `game none` to clear",
+			callback:    commandGame,
+		},
+		"moves": {
+			name:        "moves",
+			description: "list the moves a pokemon learns in the selected game, or --version-group <group>",
+			callback:    commandMoves,
+		},
+		"lang": {
+			name:        "lang",
+			description: "display names and descriptions in another language, e.g. `lang de`; `lang index` saves every pokemon, move and item name so they can be typed",
+			callback:    commandLang,
+		},
+		"pokedex": {
+			name:        "pokedex",
+			description: "list caught pokemon, optionally --type <type> --sort id|name|bst|weight|caught --min-stat attack=100; `pokedex progress [--dex <pokedex>]` shows seen and caught counts",
+			callback:    commandPokedex,
+		},
+		"dex": {
+			name:        "dex",
+			description: "list a pokedex as a checklist of caught pokemon, optionally --page <n>",
+			callback:    commandDex,
+		},
+		"info": {
+			name:        "info",
+			description: "show a summary of a pokemon by name, id or id range like 1-151",
+			callback:    commandInfo,
+		},
+		"random": {
+			name:        "random",
+			description: "show a random pokemon, optionally --gen <n>",
+			callback:    commandRandom,
+		},
+		"forms": {
+			name:        "forms",
+			description: "list the varieties and forms of a species by name or id, e.g. alolan or mega forms",
+			callback:    commandForms,
+		},
+		"history": {
+			name:        "history",
+			description: "show how a pokemon's types and abilities changed per generation",
+			callback:    commandHistory,
+		},
+		"query": {
+			name:        "query",
+			description: "find caught pokemon by --type, --species, --location, --min-stat attack=100, --since and --until",
+			callback:    commandQuery,
+		},
+		"profile": {
+			name:        "profile",
+			description: "manage trainer profiles: profile list|new|switch|delete|rename",
+			callback:    commandProfile,
+		},
+		"box": {
+			name:        "box",
+			description: "list the individual pokemon in your PC box",
+			callback:    commandBox,
+		},
+		"release": {
+			name:        "release",
+			description: "release a pokemon from your box by its id, its species stays caught",
+			callback:    commandRelease,
+		},
+		"nickname": {
+			name:        "nickname",
+			description: "give a pokemon in your box a nickname: nickname <id> <name...>",
+			callback:    commandNickname,
+		},
+		"party": {
+			name:        "party",
+			description: "manage your party of up to six pokemon from the box: party add|remove|swap|show, `party analyze` for its type coverage",
+			callback:    commandParty,
+		},
+		"tm": {
+			name:        "tm",
+			description: "show the move a TM/HM teaches and which of your pokemon can learn it, optionally --version-group <group>",
+			callback:    commandTm,
+		},
 	}
 
+	lang := flag.String("lang", defaultLang, "language for names and descriptions, e.g. ja, de, fr, es")
+	profile := flag.String("profile", save.DefaultProfile, "trainer profile to play")
+	flag.Parse()
+	langSet := false
+	flag.Visit(func(f *flag.Flag) {
+		langSet = langSet || f.Name == "lang"
+	})
+
 	scanner := bufio.NewScanner(os.Stdin)
 
 	conf := config{}
 	conf.cache = pokecache.NewCache(5 * time.Second)
-	conf.pokedex = make(map[string]pokeapi.PokemonInfo)
+	conf.lang = *lang
+
+	if err := save.ValidateProfileName(*profile); err != nil {
+		fmt.Println(err)
+		return
+	}
+	conf.profile = *profile
+	dataDir, err := save.DataDir()
+	if err != nil {
+		fmt.Printf("%v, your pokedex won't be saved\n", err)
+	} else if err := save.MoveLegacySave(dataDir); err != nil {
+		fmt.Println(err)
+	}
+	conf.dataDir = dataDir
+	if err := loadProfile(&conf); err != nil {
+		fmt.Println(err)
+		return
+	}
+	if langSet {
+		conf.lang = *lang
+	}
 
 	for {
 		fmt.Print("Pokedex> ")
@@ -226,6 +692,7 @@ func main() {
 		userInputRaw := scanner.Text()
 		userInput := strings.ToLower(strings.TrimSpace(userInputRaw))
 		commands := strings.Fields(userInput)
+		conf.input = strings.TrimSpace(userInputRaw)
 
 		commandMap, ok := commandRegistry[commands[0]]
 
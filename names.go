@@ -0,0 +1,145 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/4mewes/pokedex/internal/pokeapi"
+)
+
+const maxSuggestions = 3
+
+// levenshtein returns the number of single character edits needed to turn
+// a into b.
+func levenshtein(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
+
+// matchName looks input up in candidates. An exact hit is returned as is;
+// otherwise the single closest candidate is returned as a correction when
+// there is one, and the closest candidates are returned as suggestions.
+func matchName(input string, candidates []string) (string, []string) {
+	type scoredName struct {
+		name     string
+		distance int
+	}
+	threshold := max(2, len(input)/3)
+	scored := []scoredName{}
+	for _, candidate := range candidates {
+		if candidate == input {
+			return candidate, nil
+		}
+		distance := levenshtein(input, candidate)
+		if strings.HasPrefix(candidate, input) {
+			// "canalave-city" should find "canalave-city-area"
+			distance = min(distance, 1)
+		}
+		if distance <= threshold {
+			scored = append(scored, scoredName{name: candidate, distance: distance})
+		}
+	}
+	sort.SliceStable(scored, func(i, j int) bool {
+		if scored[i].distance != scored[j].distance {
+			return scored[i].distance < scored[j].distance
+		}
+		return scored[i].name < scored[j].name
+	})
+
+	suggestions := []string{}
+	for i := 0; i < len(scored) && i < maxSuggestions; i++ {
+		suggestions = append(suggestions, scored[i].name)
+	}
+	if len(scored) == 1 || (len(scored) > 1 && scored[0].distance < scored[1].distance) {
+		if scored[0].distance <= 2 {
+			return scored[0].name, suggestions
+		}
+	}
+	return "", suggestions
+}
+
+// nameIndex returns every name of an endpoint, e.g. "pokemon" or
+// "location-area". The lists are kept for the whole session since they
+// rarely change and are too big to refetch after every cache expiry.
+func nameIndex(conf *config, endpoint string) ([]string, error) {
+	if names, ok := conf.nameIndex[endpoint]; ok {
+		return names, nil
+	}
+	url := "https://pokeapi.co/api/v2/" + endpoint + "/?limit=100000"
+	resourceListRes, err := pokeapi.GetResourceList(url, conf.cache)
+	if err != nil {
+		return nil, fmt.Errorf("error in GetResourceList: %w", err)
+	}
+	names := []string{}
+	for _, resource := range resourceListRes.Results {
+		names = append(names, resource.Name)
+	}
+	if conf.nameIndex == nil {
+		conf.nameIndex = make(map[string][]string)
+	}
+	conf.nameIndex[endpoint] = names
+	return names, nil
+}
+
+// resolveInCandidates corrects a misspelled name against candidates,
+// telling the user what happened. It reports false when nothing matched.
+func resolveInCandidates(input string, candidates []string) (string, bool) {
+	match, suggestions := matchName(input, candidates)
+	switch {
+	case match == input:
+		return input, true
+	case match != "":
+		fmt.Printf("%s not found, assuming you meant %s\n", input, match)
+		return match, true
+	case len(suggestions) > 0:
+		fmt.Printf("%s not found. Did you mean: %s?\n", input, strings.Join(suggestions, ", "))
+		return "", false
+	default:
+		fmt.Printf("%s not found\n", input)
+		return "", false
+	}
+}
+
+// resolveName corrects a name before it is used to build an api url for
+// the given endpoint. If the name index can't be loaded the name is passed
+// through unchanged and the lookup itself reports any error.
+func resolveName(conf *config, endpoint string, input string) (string, bool) {
+	names, err := nameIndex(conf, endpoint)
+	if err != nil {
+		return input, true
+	}
+	if slices.Contains(names, input) {
+		return input, true
+	}
+	// localized names are matched alongside the slugs, so a misspelled
+	// localized name gets corrected too
+	localized := localizedCandidates(conf, endpoint)
+	candidates := slices.Clone(names)
+	for name, slug := range localized {
+		if slices.Contains(names, slug) {
+			candidates = append(candidates, name)
+		}
+	}
+	match, ok := resolveInCandidates(input, candidates)
+	if slug, isLocalized := localized[match]; ok && isLocalized {
+		return slug, true
+	}
+	return match, ok
+}
@@ -0,0 +1,58 @@
+package main
+
+import "testing"
+
+func TestLevenshtein(t *testing.T) {
+	cases := []struct {
+		a        string
+		b        string
+		expected int
+	}{
+		{a: "pikachu", b: "pikachu", expected: 0},
+		{a: "pikachuu", b: "pikachu", expected: 1},
+		{a: "bulbsaur", b: "bulbasaur", expected: 1},
+		{a: "charmader", b: "charmander", expected: 1},
+		{a: "kitten", b: "sitting", expected: 3},
+		{a: "", b: "ditto", expected: 5},
+	}
+
+	for _, c := range cases {
+		actual := levenshtein(c.a, c.b)
+		if actual != c.expected {
+			t.Errorf("levenshtein(%q, %q): expected %d, got %d", c.a, c.b, c.expected, actual)
+		}
+	}
+}
+
+func TestMatchName(t *testing.T) {
+	candidates := []string{
+		"pikachu",
+		"pichu",
+		"raichu",
+		"canalave-city-area",
+		"pidgey",
+		"pidgeot",
+		"pidgeotto",
+	}
+	cases := []struct {
+		input           string
+		expectedMatch   string
+		wantSuggestions bool
+	}{
+		{input: "pikachu", expectedMatch: "pikachu"},
+		{input: "pikachuu", expectedMatch: "pikachu", wantSuggestions: true},
+		{input: "canalave-city", expectedMatch: "canalave-city-area", wantSuggestions: true},
+		{input: "pidge", expectedMatch: "", wantSuggestions: true},
+		{input: "mewtwo", expectedMatch: "", wantSuggestions: false},
+	}
+
+	for _, c := range cases {
+		match, suggestions := matchName(c.input, candidates)
+		if match != c.expectedMatch {
+			t.Errorf("matchName(%q): expected match %q, got %q", c.input, c.expectedMatch, match)
+		}
+		if c.wantSuggestions != (len(suggestions) > 0) {
+			t.Errorf("matchName(%q): unexpected suggestions %v", c.input, suggestions)
+		}
+	}
+}
@@ -1,8 +1,103 @@
 package main
 
-import "strings"
+import (
+	"strings"
+	"unicode"
+)
 
 func cleanInput(text string) []string {
 	
 	return strings.Fields(strings.TrimSpace(text))
 }
+
+// parseFlags splits command args into positional args and --flag values.
+// Flags take the following arg as their value (or use --flag=value),
+// except the ones listed in boolFlags, which are set to "true".
+func parseFlags(args []string, boolFlags ...string) ([]string, map[string]string) {
+	positional := []string{}
+	flags := make(map[string]string)
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if !strings.HasPrefix(arg, "--") {
+			positional = append(positional, arg)
+			continue
+		}
+		name := strings.TrimPrefix(arg, "--")
+		if key, value, ok := strings.Cut(name, "="); ok {
+			flags[key] = value
+			continue
+		}
+		isBool := false
+		for _, boolFlag := range boolFlags {
+			if boolFlag == name {
+				isBool = true
+			}
+		}
+		if isBool || i+1 >= len(args) {
+			flags[name] = "true"
+			continue
+		}
+		flags[name] = args[i+1]
+		i++
+	}
+	return positional, flags
+}
+
+// fieldSpans returns the start and end of each whitespace separated field
+// of input.
+func fieldSpans(input string) [][2]int {
+	spans := [][2]int{}
+	start := -1
+	for i, r := range input {
+		if unicode.IsSpace(r) {
+			if start >= 0 {
+				spans = append(spans, [2]int{start, i})
+				start = -1
+			}
+		} else if start < 0 {
+			start = i
+		}
+	}
+	if start >= 0 {
+		spans = append(spans, [2]int{start, len(input)})
+	}
+	return spans
+}
+
+// rawTail returns the raw input line after its first n fields, keeping
+// case and spacing for free text like nicknames.
+func rawTail(input string, n int) string {
+	spans := fieldSpans(input)
+	if n >= len(spans) {
+		return ""
+	}
+	return strings.TrimSpace(input[spans[n][0]:])
+}
+
+// rawFlag returns the raw value of --name up to the next flag, keeping case
+// and spacing.
+func rawFlag(input string, name string) string {
+	spans := fieldSpans(input)
+	for i, span := range spans {
+		field := input[span[0]:span[1]]
+		start, end := -1, -1
+		if strings.EqualFold(field, "--"+name) {
+			if i+1 < len(spans) {
+				start, end = spans[i+1][0], spans[i+1][0]
+			}
+		} else if len(field) > len(name)+3 && strings.EqualFold(field[:len(name)+3], "--"+name+"=") {
+			start, end = span[0]+len(name)+3, span[1]
+		}
+		if start < 0 {
+			continue
+		}
+		for _, next := range spans[i+1:] {
+			if strings.HasPrefix(input[next[0]:next[1]], "--") {
+				break
+			}
+			end = next[1]
+		}
+		return input[start:end]
+	}
+	return ""
+}
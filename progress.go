@@ -0,0 +1,36 @@
+package main
+
+import "fmt"
+
+// dexProgress counts how many species of a pokedex or generation have been
+// seen and caught.
+type dexProgress struct {
+	name   string
+	seen   int
+	caught int
+	total  int
+}
+
+func countProgress(name string, species []string, seen map[string]bool, caught map[string]bool) dexProgress {
+	progress := dexProgress{name: name, total: len(species)}
+	for _, s := range species {
+		// a caught species was seen even if it was caught before seen
+		// species were tracked
+		if seen[s] || caught[s] {
+			progress.seen++
+		}
+		if caught[s] {
+			progress.caught++
+		}
+	}
+	return progress
+}
+
+// formatCompletion shows a count out of a total with its percentage,
+// e.g. "12/151 (7.9%)".
+func formatCompletion(count int, total int) string {
+	if total == 0 {
+		return fmt.Sprintf("%d/0", count)
+	}
+	return fmt.Sprintf("%d/%d (%.1f%%)", count, total, float64(count)*100/float64(total))
+}
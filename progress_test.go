@@ -0,0 +1,55 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/4mewes/pokedex/internal/pokeapi"
+)
+
+func TestCountProgress(t *testing.T) {
+	species := []string{"bulbasaur", "ivysaur", "venusaur", "charmander"}
+	seen := map[string]bool{"bulbasaur": true, "ivysaur": true, "pikachu": true}
+	caught := map[string]bool{"bulbasaur": true, "charmander": true}
+
+	progress := countProgress("test", species, seen, caught)
+	if progress.total != 4 {
+		t.Errorf("expected a total of 4, got %d", progress.total)
+	}
+	if progress.seen != 3 {
+		t.Errorf("expected 3 seen, got %d", progress.seen)
+	}
+	if progress.caught != 2 {
+		t.Errorf("expected 2 caught, got %d", progress.caught)
+	}
+}
+
+func TestFormatCompletion(t *testing.T) {
+	cases := []struct {
+		count    int
+		total    int
+		expected string
+	}{
+		{count: 12, total: 151, expected: "12/151 (7.9%)"},
+		{count: 151, total: 151, expected: "151/151 (100.0%)"},
+		{count: 0, total: 0, expected: "0/0"},
+	}
+
+	for _, c := range cases {
+		actual := formatCompletion(c.count, c.total)
+		if actual != c.expected {
+			t.Errorf("formatCompletion(%d, %d) = %q, expected %q", c.count, c.total, actual, c.expected)
+		}
+	}
+}
+
+func TestMarkSeen(t *testing.T) {
+	conf := &config{seen: make(map[string]bool)}
+	markSeen(conf, pokeapi.PokemonInfo{Name: "deoxys-normal", Species: pokeapi.Species{Name: "deoxys"}})
+	markSeen(conf, pokeapi.PokemonInfo{Name: "pidgey"})
+	if !conf.seen["deoxys"] || conf.seen["deoxys-normal"] {
+		t.Errorf("expected deoxys-normal to be seen as its species, got %v", conf.seen)
+	}
+	if !conf.seen["pidgey"] {
+		t.Errorf("expected pidgey to fall back to its own name, got %v", conf.seen)
+	}
+}
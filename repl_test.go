@@ -35,3 +35,86 @@ func TestCleanInput(t *testing.T) {
 	}
 }
 }
+
+func TestParseFlags(t *testing.T) {
+	cases := []struct {
+		input      []string
+		boolFlags  []string
+		positional []string
+		flags      map[string]string
+	}{
+		{
+			input:      []string{"pikachu"},
+			positional: []string{"pikachu"},
+			flags:      map[string]string{},
+		},
+		{
+			input:      []string{"pikachu", "--version", "red"},
+			positional: []string{"pikachu"},
+			flags:      map[string]string{"version": "red"},
+		},
+		{
+			input:      []string{"--version=blue", "route-1-area"},
+			positional: []string{"route-1-area"},
+			flags:      map[string]string{"version": "blue"},
+		},
+		{
+			input:      []string{"--detail", "route-1-area"},
+			boolFlags:  []string{"detail"},
+			positional: []string{"route-1-area"},
+			flags:      map[string]string{"detail": "true"},
+		},
+		{
+			input:      []string{"route-1-area", "--detail"},
+			positional: []string{"route-1-area"},
+			flags:      map[string]string{"detail": "true"},
+		},
+	}
+
+	for _, c := range cases {
+		positional, flags := parseFlags(c.input, c.boolFlags...)
+		if len(positional) != len(c.positional) {
+			t.Errorf("positional args %v do not match expected %v", positional, c.positional)
+			continue
+		}
+		for i := range positional {
+			if positional[i] != c.positional[i] {
+				t.Errorf("positional args %v do not match expected %v", positional, c.positional)
+			}
+		}
+		if len(flags) != len(c.flags) {
+			t.Errorf("flags %v do not match expected %v", flags, c.flags)
+		}
+		for key, value := range c.flags {
+			if flags[key] != value {
+				t.Errorf("flag %s: expected %q, got %q", key, value, flags[key])
+			}
+		}
+	}
+}
+
+func TestRawInput(t *testing.T) {
+	cases := []struct {
+		input    string
+		n        int
+		flag     string
+		tail     string
+		rawValue string
+	}{
+		{input: "nickname 3 Sir  Quacks", n: 2, flag: "nickname", tail: "Sir  Quacks"},
+		{input: "nickname 3", n: 2, flag: "nickname"},
+		{input: "catch psyduck --nickname Sir Quacks", n: 1, flag: "nickname", tail: "psyduck --nickname Sir Quacks", rawValue: "Sir Quacks"},
+		{input: "catch psyduck --Nickname=Sir Quacks --x y", n: 1, flag: "nickname", tail: "psyduck --Nickname=Sir Quacks --x y", rawValue: "Sir Quacks"},
+		{input: "catch psyduck --nickname=Duck", n: 1, flag: "nickname", tail: "psyduck --nickname=Duck", rawValue: "Duck"},
+		{input: "catch psyduck --nickname", n: 1, flag: "nickname", tail: "psyduck --nickname"},
+	}
+
+	for _, c := range cases {
+		if tail := rawTail(c.input, c.n); tail != c.tail {
+			t.Errorf("rawTail(%q, %d): expected %q, got %q", c.input, c.n, c.tail, tail)
+		}
+		if value := rawFlag(c.input, c.flag); value != c.rawValue {
+			t.Errorf("rawFlag(%q, %q): expected %q, got %q", c.input, c.flag, c.rawValue, value)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/4mewes/pokedex/internal/pokeapi"
+	"github.com/4mewes/pokedex/internal/save"
+)
+
+// loadProfile replaces the session's pokedex, settings and stats with the
+// ones saved in conf.profile.
+func loadProfile(conf *config) error {
+	if conf.pokedex != nil {
+		conf.pokedex.Close()
+	}
+	conf.stats = save.Stats{}
+	conf.caught = make(map[string]bool)
+	conf.seen = make(map[string]bool)
+	conf.party = []int{}
+	if conf.dataDir == "" {
+		store, err := save.OpenStore("")
+		conf.pokedex = store
+		return err
+	}
+	file, err := save.Load(save.ProfilePath(conf.dataDir, conf.profile))
+	if err != nil {
+		return fmt.Errorf("error loading profile %s: %w", conf.profile, err)
+	}
+	store, err := save.OpenStore(save.StorePath(conf.dataDir, conf.profile))
+	if err != nil {
+		return fmt.Errorf("error loading profile %s: %w", conf.profile, err)
+	}
+
+	conf.pokedex = store
+	conf.stats = file.Stats
+	for _, species := range file.Caught {
+		conf.caught[species] = true
+	}
+	for _, species := range file.Seen {
+		conf.seen[species] = true
+	}
+	for _, uid := range file.Party {
+		// skip party members that were released behind our back
+		if _, ok := store.Get(uid); ok {
+			conf.party = append(conf.party, uid)
+		}
+	}
+	conf.lang = file.Settings.Lang
+	if conf.lang == "" {
+		conf.lang = defaultLang
+	}
+	conf.game = file.Settings.Game
+	conf.versionGroup = file.Settings.VersionGroup
+	conf.generation = file.Settings.Generation
+	return nil
+}
+
+// writeProfile saves settings, stats, seen and caught species and the
+// party. The pokemon in the box are written to the pokemon log as soon as
+// they are caught.
+func writeProfile(conf *config) error {
+	if conf.dataDir == "" {
+		return nil
+	}
+	file := save.File{
+		Settings: save.Settings{
+			Lang:         conf.lang,
+			Game:         conf.game,
+			VersionGroup: conf.versionGroup,
+			Generation:   conf.generation,
+		},
+		Stats:  conf.stats,
+		Caught: sortedSpecies(conf.caught),
+		Seen:   sortedSpecies(conf.seen),
+		Party:  conf.party,
+	}
+	if err := save.Write(save.ProfilePath(conf.dataDir, conf.profile), file); err != nil {
+		return fmt.Errorf("error writing profile %s: %w", conf.profile, err)
+	}
+	return nil
+}
+
+func sortedSpecies(set map[string]bool) []string {
+	species := []string{}
+	for name := range set {
+		species = append(species, name)
+	}
+	sort.Strings(species)
+	return species
+}
+
+// caughtNames lists the names of every pokemon in the box, without duplicates.
+func caughtNames(conf *config) []string {
+	seen := make(map[string]bool)
+	names := []string{}
+	for _, record := range conf.pokedex.All() {
+		if !seen[record.Name] {
+			seen[record.Name] = true
+			names = append(names, record.Name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// pokedexEntry returns every individual of a pokemon in the box. Saved
+// records are compact, so the full api data is used when it can be fetched.
+func pokedexEntry(conf *config, name string) ([]save.CaughtPokemon, pokeapi.PokemonInfo, bool) {
+	records := conf.pokedex.ByName(name)
+	if len(records) == 0 {
+		return nil, pokeapi.PokemonInfo{}, false
+	}
+	url := "https://pokeapi.co/api/v2/pokemon/" + name + "/"
+	pokemonInfoRes, err := pokeapi.GetPokemonInfo(url, conf.cache)
+	if err != nil {
+		return records, records[0].PokemonInfo(), true
+	}
+	return records, pokemonInfoRes, true
+}
@@ -0,0 +1,31 @@
+package main
+
+import "github.com/4mewes/pokedex/internal/pokeapi"
+
+// Stats are computed for a wild pokemon with perfect IVs and no EVs.
+const (
+	defaultIV = 31
+	defaultEV = 0
+)
+
+// natureModifier returns the multiplier a nature applies to the given stat.
+// Neutral natures raise and lower the same stat, which cancels out.
+func natureModifier(nature pokeapi.NatureInfo, statName string) float64 {
+	modifier := 1.0
+	if nature.IncreasedStat.Name == statName {
+		modifier += 0.1
+	}
+	if nature.DecreasedStat.Name == statName {
+		modifier -= 0.1
+	}
+	return modifier
+}
+
+// calculateStat applies the main series stat formula (gen III onwards).
+func calculateStat(statName string, baseStat, level int, modifier float64) int {
+	core := (2*baseStat + defaultIV + defaultEV/4) * level / 100
+	if statName == "hp" {
+		return core + level + 10
+	}
+	return int(float64(core+5) * modifier)
+}
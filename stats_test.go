@@ -0,0 +1,42 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/4mewes/pokedex/internal/pokeapi"
+)
+
+func TestCalculateStat(t *testing.T) {
+	adamant := pokeapi.NatureInfo{
+		Name:          "adamant",
+		IncreasedStat: pokeapi.Stat{Name: "attack"},
+		DecreasedStat: pokeapi.Stat{Name: "special-attack"},
+	}
+	hardy := pokeapi.NatureInfo{
+		Name:          "hardy",
+		IncreasedStat: pokeapi.Stat{Name: "attack"},
+		DecreasedStat: pokeapi.Stat{Name: "attack"},
+	}
+
+	cases := []struct {
+		stat     string
+		base     int
+		level    int
+		nature   pokeapi.NatureInfo
+		expected int
+	}{
+		{stat: "hp", base: 35, level: 50, nature: adamant, expected: 110},
+		{stat: "attack", base: 55, level: 50, nature: adamant, expected: 82},
+		{stat: "special-attack", base: 50, level: 50, nature: adamant, expected: 63},
+		{stat: "speed", base: 90, level: 50, nature: adamant, expected: 110},
+		{stat: "attack", base: 55, level: 50, nature: hardy, expected: 75},
+		{stat: "attack", base: 55, level: 100, nature: hardy, expected: 146},
+	}
+
+	for _, c := range cases {
+		actual := calculateStat(c.stat, c.base, c.level, natureModifier(c.nature, c.stat))
+		if actual != c.expected {
+			t.Errorf("%s (base %d, lv %d, %s): expected %d, got %d", c.stat, c.base, c.level, c.nature.Name, c.expected, actual)
+		}
+	}
+}
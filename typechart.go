@@ -0,0 +1,180 @@
+package main
+
+import (
+	"strconv"
+
+	"github.com/4mewes/pokedex/internal/pokeapi"
+)
+
+// typeChart holds how effective each attacking type is against each
+// defending type. Pairs that are missing deal normal damage.
+type typeChart struct {
+	types      []string
+	multiplier map[string]map[string]float64
+}
+
+// newTypeChart builds the chart from the offensive damage relations of
+// every type, keeping the order the types are given in. With a generation
+// other than 0 the chart is the one of that generation: types introduced
+// later are left out and past damage relations apply.
+func newTypeChart(types []pokeapi.TypeInfo, generation int) typeChart {
+	chart := typeChart{multiplier: make(map[string]map[string]float64)}
+	for _, typeInfo := range types {
+		if generation > 0 && generationNumber(typeInfo.Generation) > generation {
+			continue
+		}
+		relations := damageRelationsFor(typeInfo, generation)
+		chart.types = append(chart.types, typeInfo.Name)
+		multiplier := make(map[string]float64)
+		for _, defending := range relations.DoubleDamageTo {
+			multiplier[defending.Name] = 2
+		}
+		for _, defending := range relations.HalfDamageTo {
+			multiplier[defending.Name] = 0.5
+		}
+		for _, defending := range relations.NoDamageTo {
+			multiplier[defending.Name] = 0
+		}
+		chart.multiplier[typeInfo.Name] = multiplier
+	}
+	return chart
+}
+
+// damageRelationsFor picks a type's damage relations in a generation. Past
+// entries hold the relations up to and including their generation, so the
+// closest one at or after the generation applies.
+func damageRelationsFor(typeInfo pokeapi.TypeInfo, generation int) pokeapi.DamageRelations {
+	relations := typeInfo.DamageRelations
+	closest := 0
+	for _, past := range typeInfo.PastDamageRelations {
+		pastGeneration := generationNumber(past.Generation)
+		if generation > 0 && pastGeneration >= generation && (closest == 0 || pastGeneration < closest) {
+			closest = pastGeneration
+			relations = past.DamageRelations
+		}
+	}
+	return relations
+}
+
+// typesForGeneration picks a pokemon's types in a generation the same way,
+// e.g. normal for clefairy before generation 6. Generation 0 keeps the
+// current types.
+func typesForGeneration(current []pokeapi.Types, past []pokeapi.PastTypes, generation int) []string {
+	types := current
+	closest := 0
+	for _, pastTypes := range past {
+		pastGeneration := generationNumber(pastTypes.Generation)
+		if generation > 0 && pastGeneration >= generation && (closest == 0 || pastGeneration < closest) {
+			closest = pastGeneration
+			types = pastTypes.Types
+		}
+	}
+	names := []string{}
+	for _, pokemonType := range types {
+		names = append(names, pokemonType.Type.Name)
+	}
+	return names
+}
+
+// effectiveness is the damage multiplier of an attacking type against a
+// pokemon with one or two types, e.g. 4 for ice against dragon/flying.
+func (c typeChart) effectiveness(attacking string, defending []string) float64 {
+	effectiveness := 1.0
+	for _, defendingType := range defending {
+		if multiplier, ok := c.multiplier[attacking][defendingType]; ok {
+			effectiveness *= multiplier
+		}
+	}
+	return effectiveness
+}
+
+type partyMember struct {
+	name  string
+	types []string
+}
+
+// partyAnalysis maps every type to the party members it matters for.
+type partyAnalysis struct {
+	// attacking type: members it hits super effectively
+	weak map[string][]string
+	// attacking type: members that take reduced, but some, damage from it
+	resist map[string][]string
+	// attacking type: members it can't hurt
+	immune map[string][]string
+	// defending type: members whose STAB hits it super effectively
+	coveredBy map[string][]string
+}
+
+func analyzeParty(chart typeChart, members []partyMember) partyAnalysis {
+	analysis := partyAnalysis{
+		weak:      make(map[string][]string),
+		resist:    make(map[string][]string),
+		immune:    make(map[string][]string),
+		coveredBy: make(map[string][]string),
+	}
+	for _, typeName := range chart.types {
+		for _, member := range members {
+			effectiveness := chart.effectiveness(typeName, member.types)
+			switch {
+			case effectiveness == 0:
+				analysis.immune[typeName] = append(analysis.immune[typeName], member.name)
+			case effectiveness < 1:
+				analysis.resist[typeName] = append(analysis.resist[typeName], member.name)
+			case effectiveness > 1:
+				analysis.weak[typeName] = append(analysis.weak[typeName], member.name)
+			}
+			if bestStab(chart, member, typeName) > 1 {
+				analysis.coveredBy[typeName] = append(analysis.coveredBy[typeName], member.name)
+			}
+		}
+	}
+	return analysis
+}
+
+// bestStab is the best multiplier one of the member's own types deals
+// against a defending type.
+func bestStab(chart typeChart, member partyMember, defending string) float64 {
+	best := 0.0
+	for _, typeName := range member.types {
+		best = max(best, chart.effectiveness(typeName, []string{defending}))
+	}
+	return best
+}
+
+// sharedWeaknesses lists the attacking types that hit two or more members
+// super effectively.
+func (a partyAnalysis) sharedWeaknesses(chart typeChart) []string {
+	shared := []string{}
+	for _, typeName := range chart.types {
+		if len(a.weak[typeName]) >= 2 {
+			shared = append(shared, typeName)
+		}
+	}
+	return shared
+}
+
+// uncovered lists the defending types no member's STAB hits super
+// effectively.
+func (a partyAnalysis) uncovered(chart typeChart) []string {
+	uncovered := []string{}
+	for _, typeName := range chart.types {
+		if len(a.coveredBy[typeName]) == 0 {
+			uncovered = append(uncovered, typeName)
+		}
+	}
+	return uncovered
+}
+
+// formatMultiplier shows a damage multiplier for the coverage matrix,
+// leaving neutral damage as a dash so the interesting cells stand out.
+func formatMultiplier(multiplier float64) string {
+	switch multiplier {
+	case 1:
+		return "-"
+	case 0.5:
+		return "½x"
+	case 0.25:
+		return "¼x"
+	}
+	return strconv.FormatFloat(multiplier, 'g', -1, 64) + "x"
+}
@@ -0,0 +1,183 @@
+package main
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/4mewes/pokedex/internal/pokeapi"
+)
+
+func testTypeChart() typeChart {
+	types := func(names ...string) []pokeapi.Type {
+		result := []pokeapi.Type{}
+		for _, name := range names {
+			result = append(result, pokeapi.Type{Name: name})
+		}
+		return result
+	}
+	return newTypeChart([]pokeapi.TypeInfo{
+		{Name: "fire", DamageRelations: pokeapi.DamageRelations{
+			DoubleDamageTo: types("grass"),
+			HalfDamageTo:   types("fire", "water"),
+		}},
+		{Name: "water", DamageRelations: pokeapi.DamageRelations{
+			DoubleDamageTo: types("fire", "ground"),
+			HalfDamageTo:   types("water", "grass"),
+		}},
+		{Name: "grass", DamageRelations: pokeapi.DamageRelations{
+			DoubleDamageTo: types("water", "ground"),
+			HalfDamageTo:   types("fire", "grass", "flying"),
+		}},
+		{Name: "electric", DamageRelations: pokeapi.DamageRelations{
+			DoubleDamageTo: types("water", "flying"),
+			HalfDamageTo:   types("grass", "electric"),
+			NoDamageTo:     types("ground"),
+		}},
+		{Name: "ground", DamageRelations: pokeapi.DamageRelations{
+			DoubleDamageTo: types("fire", "electric"),
+			HalfDamageTo:   types("grass"),
+			NoDamageTo:     types("flying"),
+		}},
+		{Name: "flying", DamageRelations: pokeapi.DamageRelations{
+			DoubleDamageTo: types("grass"),
+			HalfDamageTo:   types("electric"),
+		}},
+	}, 0)
+}
+
+func TestTypeChartForGeneration(t *testing.T) {
+	generation := func(number string) pokeapi.Generation {
+		return pokeapi.Generation{Url: "https://pokeapi.co/api/v2/generation/" + number + "/"}
+	}
+	types := []pokeapi.TypeInfo{
+		{Name: "ghost", Generation: generation("1"),
+			DamageRelations: pokeapi.DamageRelations{
+				DoubleDamageTo: []pokeapi.Type{{Name: "psychic"}, {Name: "ghost"}},
+			},
+			PastDamageRelations: []pokeapi.PastDamageRelations{
+				{Generation: generation("1"), DamageRelations: pokeapi.DamageRelations{
+					DoubleDamageTo: []pokeapi.Type{{Name: "ghost"}},
+					NoDamageTo:     []pokeapi.Type{{Name: "psychic"}},
+				}},
+			},
+		},
+		{Name: "psychic", Generation: generation("1")},
+		{Name: "fairy", Generation: generation("6")},
+	}
+
+	cases := []struct {
+		generation int
+		types      []string
+		expected   float64
+	}{
+		{generation: 0, types: []string{"ghost", "psychic", "fairy"}, expected: 2},
+		{generation: 1, types: []string{"ghost", "psychic"}, expected: 0},
+		{generation: 2, types: []string{"ghost", "psychic"}, expected: 2},
+	}
+	for _, c := range cases {
+		chart := newTypeChart(types, c.generation)
+		if !slices.Equal(chart.types, c.types) {
+			t.Errorf("generation %d: expected types %v, got %v", c.generation, c.types, chart.types)
+		}
+		if actual := chart.effectiveness("ghost", []string{"psychic"}); actual != c.expected {
+			t.Errorf("generation %d: expected ghost to deal %vx to psychic, got %vx", c.generation, c.expected, actual)
+		}
+	}
+}
+
+func TestTypesForGeneration(t *testing.T) {
+	typeList := func(names ...string) []pokeapi.Types {
+		result := []pokeapi.Types{}
+		for i, name := range names {
+			result = append(result, pokeapi.Types{Slot: i + 1, Type: pokeapi.Type{Name: name}})
+		}
+		return result
+	}
+	generation := func(number string) pokeapi.Generation {
+		return pokeapi.Generation{Url: "https://pokeapi.co/api/v2/generation/" + number + "/"}
+	}
+	// made up history to check the closest past entry wins
+	current := typeList("fairy")
+	past := []pokeapi.PastTypes{
+		{Generation: generation("5"), Types: typeList("normal")},
+		{Generation: generation("2"), Types: typeList("normal", "flying")},
+	}
+
+	cases := map[int][]string{
+		0: {"fairy"},
+		1: {"normal", "flying"},
+		2: {"normal", "flying"},
+		3: {"normal"},
+		5: {"normal"},
+		6: {"fairy"},
+	}
+	for generation, expected := range cases {
+		if actual := typesForGeneration(current, past, generation); !slices.Equal(actual, expected) {
+			t.Errorf("generation %d: expected %v, got %v", generation, expected, actual)
+		}
+	}
+}
+
+func TestEffectiveness(t *testing.T) {
+	chart := testTypeChart()
+	cases := []struct {
+		attacking string
+		defending []string
+		expected  float64
+	}{
+		{attacking: "water", defending: []string{"fire"}, expected: 2},
+		{attacking: "water", defending: []string{"fire", "ground"}, expected: 4},
+		{attacking: "grass", defending: []string{"water", "flying"}, expected: 1},
+		{attacking: "fire", defending: []string{"water"}, expected: 0.5},
+		{attacking: "electric", defending: []string{"water", "ground"}, expected: 0},
+		{attacking: "flying", defending: []string{"water"}, expected: 1},
+	}
+
+	for _, c := range cases {
+		actual := chart.effectiveness(c.attacking, c.defending)
+		if actual != c.expected {
+			t.Errorf("effectiveness(%s, %v) = %v, expected %v", c.attacking, c.defending, actual, c.expected)
+		}
+	}
+}
+
+func TestAnalyzeParty(t *testing.T) {
+	chart := testTypeChart()
+	party := []partyMember{
+		{name: "charmander", types: []string{"fire"}},
+		{name: "geodude", types: []string{"ground"}},
+		{name: "pidgey", types: []string{"flying"}},
+	}
+	analysis := analyzeParty(chart, party)
+
+	if !slices.Equal(analysis.weak["water"], []string{"charmander", "geodude"}) {
+		t.Errorf("expected charmander and geodude to be weak to water, got %v", analysis.weak["water"])
+	}
+	if !slices.Equal(analysis.immune["electric"], []string{"geodude"}) {
+		t.Errorf("expected geodude to be immune to electric, got %v", analysis.immune["electric"])
+	}
+	if !slices.Equal(analysis.immune["ground"], []string{"pidgey"}) {
+		t.Errorf("expected pidgey to be immune to ground, got %v", analysis.immune["ground"])
+	}
+	if !slices.Equal(analysis.resist["grass"], []string{"charmander", "pidgey"}) {
+		t.Errorf("expected charmander and pidgey to resist grass, got %v", analysis.resist["grass"])
+	}
+	if shared := analysis.sharedWeaknesses(chart); !slices.Equal(shared, []string{"water"}) {
+		t.Errorf("expected water to be the only shared weakness, got %v", shared)
+	}
+	if !slices.Equal(analysis.coveredBy["grass"], []string{"charmander", "pidgey"}) {
+		t.Errorf("expected charmander and pidgey to cover grass, got %v", analysis.coveredBy["grass"])
+	}
+	if uncovered := analysis.uncovered(chart); !slices.Equal(uncovered, []string{"water", "ground", "flying"}) {
+		t.Errorf("expected water, ground and flying to be uncovered, got %v", uncovered)
+	}
+}
+
+func TestFormatMultiplier(t *testing.T) {
+	cases := map[float64]string{0: "0x", 0.25: "¼x", 0.5: "½x", 1: "-", 2: "2x", 4: "4x"}
+	for multiplier, expected := range cases {
+		if actual := formatMultiplier(multiplier); actual != expected {
+			t.Errorf("formatMultiplier(%v) = %q, expected %q", multiplier, actual, expected)
+		}
+	}
+}